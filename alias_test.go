@@ -0,0 +1,88 @@
+package enum_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleDefAlias() {
+	type TaskStatus string
+	var (
+		TaskStatusTodo       = enum.Def[TaskStatus]("todo")
+		TaskStatusInProgress = enum.Def[TaskStatus]("in-progress")
+	)
+	_ = TaskStatusTodo
+	if err := enum.DefAlias(TaskStatusInProgress, "in_progress"); err != nil {
+		panic(err)
+	}
+
+	fmt.Println(enum.IsValid[TaskStatus]("in_progress"))
+	fmt.Println(enum.Parse[TaskStatus]("in_progress"))
+	fmt.Println(enum.ValuesOf[TaskStatus]())
+	fmt.Println(enum.Validate[TaskStatus]("done"))
+
+	// Output:
+	// true
+	// in-progress <nil>
+	// [todo in-progress]
+	// "done" is not a valid choice, allowed values are: "todo", "in-progress"
+}
+
+func ExampleDefAliasName() {
+	type Permission int
+	PermissionWrite := enum.DefNamed[Permission](4, "write")
+	if err := enum.DefAliasName(PermissionWrite, "rw"); err != nil {
+		panic(err)
+	}
+
+	fmt.Println(enum.Parse[Permission]("rw"))
+	fmt.Println(enum.NameOf(PermissionWrite))
+
+	// Output:
+	// 4 <nil>
+	// write true
+}
+
+func TestDefAliasErrors(t *testing.T) {
+	type Color string
+	enum.Def[Color]("gray")
+	enum.Def[Color]("white")
+	if err := enum.DefAlias[Color]("gray", "grey"); err != nil {
+		t.Fatal(err)
+	}
+	if err := enum.DefAlias[Color]("gray", "grey"); err != nil {
+		t.Errorf("repeated alias: %v", err)
+	}
+	for _, tc := range []struct{ canonical, alias Color }{
+		{"black", "dark"}, // canonical is undefined
+		{"gray", "white"}, // alias is defined
+		{"white", "grey"}, // alias of another value
+	} {
+		if err := enum.DefAlias(tc.canonical, tc.alias); err == nil {
+			t.Errorf("DefAlias(%q, %q) succeeded", tc.canonical, tc.alias)
+		}
+	}
+
+	type Level int
+	enum.DefNamed[Level](1, "low")
+	enum.DefNamed[Level](2, "high")
+	if err := enum.DefAliasName[Level](3, "mid"); err == nil {
+		t.Error("DefAliasName of undefined value succeeded")
+	}
+	if err := enum.DefAliasName[Level](2, "low"); err == nil {
+		t.Error("DefAliasName with taken name succeeded")
+	}
+}
+
+func TestClearAliases(t *testing.T) {
+	type Color string
+	enum.Def[Color]("gray")
+	_ = enum.DefAlias[Color]("gray", "grey")
+	enum.Clear[Color]()
+	enum.Def[Color]("grey")
+	if v, err := enum.Parse[Color]("grey"); err != nil || v != "grey" {
+		t.Errorf("Parse(grey) = %q, %v after Clear", v, err)
+	}
+}
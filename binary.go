@@ -0,0 +1,98 @@
+package enum
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+)
+
+// Binary wraps a value of enum T to validate it when decoding binary data,
+// it implements encoding.BinaryMarshaler and encoding.BinaryUnmarshaler with the encoding of AppendBinary.
+// Usage:
+//   data, _ := enum.Binary[Status]{Val: StatusOpen}.MarshalBinary()
+//   var b enum.Binary[Status]
+//   err := b.UnmarshalBinary(data)
+type Binary[T enumType] struct {
+	Val T
+}
+
+// MarshalBinary encodes the value, see AppendBinary.
+func (b Binary[T]) MarshalBinary() ([]byte, error) {
+	return AppendBinary(nil, b.Val), nil
+}
+
+// UnmarshalBinary decodes the value and validates it, see DecodeBinary.
+// Trailing bytes after the value are malformed input. On failure, the wrapped value is left untouched.
+func (b *Binary[T]) UnmarshalBinary(data []byte) error {
+	v, n, err := DecodeBinary[T](data)
+	if err != nil {
+		return err
+	}
+	if n != len(data) {
+		return fmt.Errorf("%w: %d trailing bytes after %s", ErrMalformed, len(data)-n, idOf[T]())
+	}
+	b.Val = v
+	return nil
+}
+
+// AppendBinary appends compact binary encoding of v to dst and returns the extended buffer.
+// Signed integer enums are encoded as varints, unsigned ones as uvarints,
+// string enums as uvarint length followed by the bytes of the string.
+// The encoding doesn't depend on definitions, v isn't validated.
+func AppendBinary[T enumType](dst []byte, v T) []byte {
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.Kind() == reflect.String:
+		s := rv.String()
+		dst = binary.AppendUvarint(dst, uint64(len(s)))
+		return append(dst, s...)
+	case rv.CanInt():
+		return binary.AppendVarint(dst, rv.Int())
+	default: // enumType permits only strings and integers
+		return binary.AppendUvarint(dst, rv.Uint())
+	}
+}
+
+// DecodeBinary decodes a value of enum T encoded by AppendBinary from the beginning of src,
+// and returns it along with the number of bytes read.
+// Returns an error wrapping ErrMalformed if src is truncated or doesn't fit T,
+// or *ValidationError if the decoded value is not defined, e.g. it was encoded by a newer version of a service.
+func DecodeBinary[T enumType](src []byte) (T, int, error) {
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	var n int
+	switch {
+	case rv.Kind() == reflect.String:
+		size, sizeLen := binary.Uvarint(src)
+		if sizeLen <= 0 || size > uint64(len(src)-sizeLen) {
+			return v, 0, fmt.Errorf("%w: truncated %s", ErrMalformed, rv.Type())
+		}
+		n = sizeLen + int(size)
+		rv.SetString(string(src[sizeLen:n]))
+	case rv.CanInt():
+		var x int64
+		x, n = binary.Varint(src)
+		if n == 0 {
+			return v, 0, fmt.Errorf("%w: truncated %s", ErrMalformed, rv.Type())
+		}
+		if n < 0 || rv.OverflowInt(x) {
+			return v, 0, fmt.Errorf("%w: varint overflows %s", ErrMalformed, rv.Type())
+		}
+		rv.SetInt(x)
+	default: // enumType permits only strings and integers
+		var x uint64
+		x, n = binary.Uvarint(src)
+		if n == 0 {
+			return v, 0, fmt.Errorf("%w: truncated %s", ErrMalformed, rv.Type())
+		}
+		if n < 0 || rv.OverflowUint(x) {
+			return v, 0, fmt.Errorf("%w: uvarint overflows %s", ErrMalformed, rv.Type())
+		}
+		rv.SetUint(x)
+	}
+	if err := Validate(v); err != nil {
+		var zero T
+		return zero, n, err
+	}
+	return v, n, nil
+}
@@ -0,0 +1,90 @@
+package enum_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleAppendBinary() {
+	var buf []byte
+	buf = enum.AppendBinary(buf, StatusMerged)
+	buf = enum.AppendBinary(buf, AccessWrite)
+	buf = enum.AppendBinary[Access](buf, 3)
+	fmt.Printf("%x\n", buf)
+
+	status, n, err := enum.DecodeBinary[Status](buf)
+	fmt.Println(status, n, err)
+	buf = buf[n:]
+	access, n, err := enum.DecodeBinary[Access](buf)
+	fmt.Println(access, n, err)
+	buf = buf[n:]
+	access, n, err = enum.DecodeBinary[Access](buf)
+	fmt.Println(access, n, err)
+	// Output:
+	// 066d65726765640806
+	// merged 7 <nil>
+	// 4 1 <nil>
+	// 0 1 3 is not a valid choice, allowed values are: 1, 2, 4
+}
+
+func TestDecodeBinary(t *testing.T) {
+	type Level int8
+	enum.DefAll[Level](-100, 0, 100)
+	type Code uint16
+	enum.DefAll[Code](1, 300)
+
+	for _, v := range enum.ValuesOf[Level]() {
+		got, n, err := enum.DecodeBinary[Level](enum.AppendBinary(nil, v))
+		if got != v || err != nil {
+			t.Errorf("DecodeBinary(AppendBinary(%d)) = %d, %d, %v", v, got, n, err)
+		}
+	}
+	for _, v := range enum.ValuesOf[Code]() {
+		got, n, err := enum.DecodeBinary[Code](enum.AppendBinary(nil, v))
+		if got != v || err != nil {
+			t.Errorf("DecodeBinary(AppendBinary(%d)) = %d, %d, %v", v, got, n, err)
+		}
+	}
+
+	malformed := []func() error{
+		func() error { _, _, err := enum.DecodeBinary[Status](nil); return err },
+		func() error { _, _, err := enum.DecodeBinary[Status]([]byte{6, 'm', 'e'}); return err },
+		func() error { _, _, err := enum.DecodeBinary[Level]([]byte{0x80}); return err },
+		func() error { _, _, err := enum.DecodeBinary[Level](enum.AppendBinary[int](nil, 200)); return err },
+		func() error { _, _, err := enum.DecodeBinary[Code](enum.AppendBinary[uint32](nil, 70000)); return err },
+	}
+	for i, f := range malformed {
+		if err := f(); !errors.Is(err, enum.ErrMalformed) {
+			t.Errorf("case %d: got %v, want ErrMalformed", i, err)
+		}
+	}
+
+	_, _, err := enum.DecodeBinary[Code](enum.AppendBinary[Code](nil, 2))
+	if !errors.Is(err, enum.ErrInvalidValue) || errors.Is(err, enum.ErrMalformed) {
+		t.Errorf("got %v, want ErrInvalidValue only", err)
+	}
+}
+
+func ExampleBinary() {
+	data, _ := enum.Binary[Status]{Val: StatusClosed}.MarshalBinary()
+	fmt.Printf("%x\n", data)
+
+	var b enum.Binary[Status]
+	fmt.Println(b.UnmarshalBinary(data), b.Val)
+
+	data, _ = enum.Binary[Access]{Val: 3}.MarshalBinary()
+	fmt.Println(b.UnmarshalBinary(data), b.Val)
+	var a enum.Binary[Access]
+	fmt.Println(a.UnmarshalBinary(data), a.Val)
+	fmt.Println(a.UnmarshalBinary([]byte{2, 0}), a.Val)
+
+	// Output:
+	// 06636c6f736564
+	// <nil> closed
+	// enum: malformed input: truncated enum_test.Status closed
+	// 3 is not a valid choice, allowed values are: 1, 2, 4 0
+	// enum: malformed input: 1 trailing bytes after enum_test.Access 0
+}
@@ -0,0 +1,58 @@
+package enum
+
+import "fmt"
+
+// intEnumType is enumType restricted to integers, e.g. for bitwise operations.
+type intEnumType interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// IsValidFlags reports whether v is a bitwise OR of defined values of bitflag enum T.
+// Zero is valid as it represents an empty set of flags.
+// Usage:
+//   type Access int
+//   var (
+//     AccessRead  = enum.Def[Access](1)
+//     AccessWrite = enum.Def[Access](4)
+//   )
+//   enum.IsValidFlags(AccessRead | AccessWrite) // true
+//   enum.IsValidFlags[Access](2)               // false
+func IsValidFlags[T intEnumType](v T) bool {
+	var covered T
+	for _, f := range snapshot[T]() {
+		if f&^v == 0 {
+			covered |= f
+		}
+	}
+	return covered == v
+}
+
+// HasFlag reports whether all bits of flag are set in v.
+func HasFlag[T intEnumType](v, flag T) bool {
+	return v&flag == flag
+}
+
+// Flags returns defined values of bitflag enum T which are set in v, in the same order as ValuesOf.
+// Bits of v that don't belong to any defined value are ignored, see FlagsStrict.
+func Flags[T intEnumType](v T) []T {
+	var flags []T
+	for _, f := range snapshot[T]() {
+		if f != 0 && v&f == f {
+			flags = append(flags, f)
+		}
+	}
+	return flags
+}
+
+// FlagsStrict is like Flags but returns an error if v has bits that don't belong to any defined value.
+func FlagsStrict[T intEnumType](v T) ([]T, error) {
+	flags := Flags(v)
+	var covered T
+	for _, f := range flags {
+		covered |= f
+	}
+	if stray := v &^ covered; stray != 0 {
+		return flags, fmt.Errorf("%s: %#b has undefined bits %#b", idOf[T]().Name(), v, stray)
+	}
+	return flags, nil
+}
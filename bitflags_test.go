@@ -0,0 +1,53 @@
+package enum_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleIsValidFlags() {
+	fmt.Println(enum.IsValidFlags(AccessRead | AccessWrite))
+	fmt.Println(enum.IsValidFlags[Access](0))
+	fmt.Println(enum.IsValidFlags[Access](8))
+	fmt.Println(enum.HasFlag(AccessRead|AccessWrite, AccessWrite))
+	fmt.Println(enum.HasFlag(AccessRead|AccessWrite, AccessComment))
+
+	// Output:
+	// true
+	// true
+	// false
+	// true
+	// false
+}
+
+func TestIsValidFlagsMultiBit(t *testing.T) {
+	type Perm uint8
+	enum.DefAll[Perm](0b011, 0b100)
+	for v, want := range map[Perm]bool{
+		0b000:  true,
+		0b011:  true,
+		0b111:  true,
+		0b001:  false, // only part of 0b011
+		0b101:  false,
+		0b1000: false,
+	} {
+		if got := enum.IsValidFlags(v); got != want {
+			t.Errorf("IsValidFlags(%#b) = %v, want %v", v, got, want)
+		}
+	}
+}
+
+func ExampleFlags() {
+	fmt.Println(enum.Flags(AccessRead | AccessWrite))
+	fmt.Println(enum.Flags[Access](0))
+	fmt.Println(enum.Flags[Access](8 | 2))
+	fmt.Println(enum.FlagsStrict[Access](8 | 2))
+
+	// Output:
+	// [1 4]
+	// []
+	// [2]
+	// [2] Access: 0b1010 has undefined bits 0b1000
+}
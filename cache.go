@@ -0,0 +1,106 @@
+package enum
+
+import (
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Caches of data derived from definitions, keyed by typeID.
+// They are filled under read lock of mu and invalidated under write lock whenever definitions change,
+// e.g. a value, name, label or formatter is defined, so they are never stale.
+var (
+	// values are always strings, lists of allowed values in error messages.
+	tails sync.Map
+	// values are always slices of enumType, defined values in ascending order.
+	sortedGroups sync.Map
+	// values are always [][]rune, defined values of string enums converted to runes, for hints.
+	runeGroups sync.Map
+	// values are always map[string][]enumType, defined values of string enums by lower-cased forms of them and their aliases.
+	foldGroups sync.Map
+)
+
+// changed invalidates caches of enum typ, mu must be held for writing.
+func changed(typ typeID) {
+	tails.Delete(typ)
+	sortedGroups.Delete(typ)
+	runeGroups.Delete(typ)
+	foldGroups.Delete(typ)
+}
+
+// clearCaches invalidates caches of all enums, mu must be held for writing.
+func clearCaches() {
+	tails.Clear()
+	sortedGroups.Clear()
+	runeGroups.Clear()
+	foldGroups.Clear()
+}
+
+// sorted returns defined values of enum typ in ascending order, the result must not be modified.
+// mu must be held.
+func sorted[T enumType](typ typeID) []T {
+	if vals, ok := sortedGroups.Load(typ); ok {
+		return vals.([]T)
+	}
+	vals, _ := groups[typ].([]T)
+	vals = slices.Clone(vals)
+	slices.Sort(vals)
+	sortedGroups.Store(typ, vals)
+	return vals
+}
+
+// runes returns defined values of string enum typ converted to runes, in the same order as ValuesOf.
+// The result must not be modified, mu must be held.
+func runes[T enumType](typ typeID) [][]rune {
+	if vals, ok := runeGroups.Load(typ); ok {
+		return vals.([][]rune)
+	}
+	vals, _ := groups[typ].([]T)
+	rs := make([][]rune, len(vals))
+	for i, v := range vals {
+		rs[i] = []rune(toString(v))
+	}
+	runeGroups.Store(typ, rs)
+	return rs
+}
+
+// folded returns defined values of string enum typ indexed by ASCII lower-cased forms of them and their aliases,
+// in the same order as ValuesOf. The result must not be modified, mu must be held.
+func folded[T stringEnumType](typ typeID) map[string][]T {
+	if index, ok := foldGroups.Load(typ); ok {
+		return index.(map[string][]T)
+	}
+	vals, _ := groups[typ].([]T)
+	vals = withoutZero(typ, vals)
+	index := make(map[string][]T, len(vals))
+	for _, v := range vals {
+		key := asciiLower(string(v))
+		index[key] = append(index[key], v)
+	}
+	var aliased []typeValue[T]
+	for k := range aliases {
+		if a, ok := k.(typeValue[T]); ok && a.typ == typ {
+			aliased = append(aliased, a)
+		}
+	}
+	slices.SortFunc(aliased, func(a, b typeValue[T]) int { return strings.Compare(string(a.val), string(b.val)) })
+	for _, a := range aliased {
+		key, c := asciiLower(string(a.val)), aliases[a].(T)
+		if !slices.Contains(index[key], c) {
+			index[key] = append(index[key], c)
+		}
+	}
+	foldGroups.Store(typ, index)
+	return index
+}
+
+// asciiLower is like strings.ToLower but lower-cases ASCII letters only, e.g. Kelvin sign is kept as-is.
+func asciiLower(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if 'A' <= c && c <= 'Z' {
+			b[i] = c + 'a' - 'A'
+		}
+	}
+	return string(b)
+}
@@ -0,0 +1,121 @@
+// Package codegen generates Go source of enum definitions from values registered at runtime,
+// e.g. loaded from a database seed, so that they can be checked in as code.
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/0xcafe-io/enum"
+)
+
+// enumType mirrors the constraint of enum package.
+type enumType interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~string
+}
+
+// Generate writes gofmt-ed source of package pkg to w, declaring type typeName with the underlying type of T,
+// and a variable for each defined value of enum T, in the same order as enum.ValuesOf.
+// Names given in enum.DefNamed and labels given in enum.DefLabeled are preserved.
+// Variables are named after the type and the name or the value, e.g. StatusInProgress for "in_progress".
+// Returns an error wrapping enum.ErrNoDefinitions if T doesn't have any definition,
+// or an error if pkg or typeName is not a valid identifier, or two values get the same variable name.
+// Usage:
+//   f, _ := os.Create("status_gen.go")
+//   err := codegen.Generate[Status]("orders", "Status", f)
+func Generate[T enumType](pkg, typeName string, w io.Writer) error {
+	if !token.IsIdentifier(pkg) {
+		return fmt.Errorf("codegen: invalid package name %q", pkg)
+	}
+	if !token.IsIdentifier(typeName) || !token.IsExported(typeName) {
+		return fmt.Errorf("codegen: invalid type name %q, it must be an exported identifier", typeName)
+	}
+	vals := enum.ValuesOf[T]()
+	if len(vals) == 0 {
+		return fmt.Errorf("codegen: %s: %w", reflect.TypeFor[T](), enum.ErrNoDefinitions)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by github.com/0xcafe-io/enum/codegen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	fmt.Fprintf(&buf, "import \"github.com/0xcafe-io/enum\"\n\n")
+	fmt.Fprintf(&buf, "type %s %s\n\n", typeName, reflect.TypeFor[T]().Kind())
+	fmt.Fprintf(&buf, "var (\n")
+	seen := make(map[string]T, len(vals))
+	for _, v := range vals {
+		literal := literalOf(v)
+		name, named := enum.NameOf(v)
+		named = named && strconv.Quote(name) != literal // string enums are named by their values implicitly
+		label, labeled := enum.LabelOf(v)
+
+		varName := typeName + identPart(literal)
+		if named {
+			varName = typeName + identPart(name)
+		}
+		if old, ok := seen[varName]; ok {
+			return fmt.Errorf("codegen: values %s and %s are both named %s", literalOf(old), literal, varName)
+		}
+		seen[varName] = v
+
+		def := fmt.Sprintf("enum.Def[%s](%s)", typeName, literal)
+		if named {
+			def = fmt.Sprintf("enum.DefNamed[%s](%s, %s)", typeName, literal, strconv.Quote(name))
+		}
+		if labeled {
+			def = fmt.Sprintf("enum.DefLabeled(%s, %s)", def, strconv.Quote(label))
+		}
+		fmt.Fprintf(&buf, "%s = %s\n", varName, def)
+	}
+	fmt.Fprintf(&buf, ")\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("codegen: %w", err)
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// literalOf returns Go literal of v.
+func literalOf[T enumType](v T) string {
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.Kind() == reflect.String:
+		return strconv.Quote(rv.String())
+	case rv.CanInt():
+		return strconv.FormatInt(rv.Int(), 10)
+	default: // enumType permits only strings and integers
+		return strconv.FormatUint(rv.Uint(), 10)
+	}
+}
+
+// identPart converts s, which is either a name or a literal, to a part of an exported identifier,
+// e.g. "in_progress" to InProgress and -1 to Neg1. Returns Empty if s doesn't contain letters or digits.
+func identPart(s string) string {
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	var sb strings.Builder
+	if strings.HasPrefix(s, "-") {
+		sb.WriteString("Neg")
+	}
+	for _, word := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		first, size := utf8.DecodeRuneInString(word)
+		sb.WriteRune(unicode.ToUpper(first))
+		sb.WriteString(word[size:])
+	}
+	if sb.Len() == 0 {
+		return "Empty"
+	}
+	return sb.String()
+}
@@ -0,0 +1,81 @@
+package codegen_test
+
+import (
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+	"github.com/0xcafe-io/enum/codegen"
+)
+
+func ExampleGenerate() {
+	type Status string
+	enum.Def[Status]("draft")
+	enum.Def[Status]("in_progress")
+	enum.DefLabeled[Status]("done", "Done \"for real\"")
+
+	if err := codegen.Generate[Status]("orders", "Status", os.Stdout); err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// // Code generated by github.com/0xcafe-io/enum/codegen. DO NOT EDIT.
+	//
+	// package orders
+	//
+	// import "github.com/0xcafe-io/enum"
+	//
+	// type Status string
+	//
+	// var (
+	// 	StatusDraft      = enum.Def[Status]("draft")
+	// 	StatusInProgress = enum.Def[Status]("in_progress")
+	// 	StatusDone       = enum.DefLabeled(enum.Def[Status]("done"), "Done \"for real\"")
+	// )
+}
+
+func ExampleGenerate_int() {
+	type Level int8
+	enum.Def[Level](-1)
+	enum.DefNamed[Level](0, "unknown")
+	enum.DefLabeled(enum.DefNamed[Level](10, "debug-verbose"), "Verbose")
+
+	if err := codegen.Generate[Level]("logs", "Level", os.Stdout); err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// // Code generated by github.com/0xcafe-io/enum/codegen. DO NOT EDIT.
+	//
+	// package logs
+	//
+	// import "github.com/0xcafe-io/enum"
+	//
+	// type Level int8
+	//
+	// var (
+	// 	LevelNeg1         = enum.Def[Level](-1)
+	// 	LevelUnknown      = enum.DefNamed[Level](0, "unknown")
+	// 	LevelDebugVerbose = enum.DefLabeled(enum.DefNamed[Level](10, "debug-verbose"), "Verbose")
+	// )
+}
+
+func TestGenerateErrors(t *testing.T) {
+	type Nothing string
+	if err := codegen.Generate[Nothing]("p", "Nothing", io.Discard); !errors.Is(err, enum.ErrNoDefinitions) {
+		t.Errorf("got %v, want ErrNoDefinitions", err)
+	}
+
+	type Mode string
+	enum.DefAll[Mode]("read-only", "read_only", "")
+	if err := codegen.Generate[Mode]("p", "Mode", io.Discard); err == nil {
+		t.Error("got nil, want error about duplicate names")
+	}
+	for _, names := range [][2]string{{"", "Mode"}, {"p", "mode"}, {"p", "Mode X"}} {
+		if err := codegen.Generate[Mode](names[0], names[1], io.Discard); err == nil {
+			t.Errorf("Generate(%q, %q) = nil, want error", names[0], names[1])
+		}
+	}
+}
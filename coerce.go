@@ -0,0 +1,93 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Coerce converts x of a dynamic type to enum T and validates it, e.g. for values of map[string]any decoded from JSON.
+// String enums accept strings and json.Number, integer enums accept integers of any size, json.Number,
+// and floats without a fractional part. Types with the same underlying kinds are accepted too.
+// Returns an error wrapping ErrTypeMismatch if x can't be represented as T, e.g. 1.5 or 300 for ~int8,
+// or the error of Validate if the converted value is not defined.
+// Usage:
+//   var payload map[string]any
+//   json.Unmarshal(data, &payload)
+//   status, err := enum.Coerce[Status](payload["status"])
+func Coerce[T enumType](x any) (T, error) {
+	v, ok := coerce[T](x)
+	if !ok {
+		var zero T
+		return zero, fmt.Errorf("%w: can't convert %T %s to %s", ErrTypeMismatch, x, formatDynamic(x), idOf[T]().Name())
+	}
+	if err := Validate(v); err != nil {
+		var zero T
+		return zero, err
+	}
+	return v, nil
+}
+
+// coerce converts x to T without validation, reports false if x can't be represented as T.
+func coerce[T enumType](x any) (v T, ok bool) {
+	if v, ok := x.(T); ok {
+		return v, true
+	}
+	if n, ok := x.(json.Number); ok {
+		return fromString[T](string(n))
+	}
+	src := reflect.ValueOf(x)
+	dst := reflect.ValueOf(&v).Elem()
+	switch {
+	case !src.IsValid():
+		return v, false
+	case dst.Kind() == reflect.String:
+		if src.Kind() != reflect.String {
+			return v, false
+		}
+		dst.SetString(src.String())
+	case src.CanInt():
+		n := src.Int()
+		if dst.CanInt() && !dst.OverflowInt(n) {
+			dst.SetInt(n)
+		} else if dst.CanUint() && n >= 0 && !dst.OverflowUint(uint64(n)) {
+			dst.SetUint(uint64(n))
+		} else {
+			return v, false
+		}
+	case src.CanUint():
+		n := src.Uint()
+		if dst.CanInt() && n <= math.MaxInt64 && !dst.OverflowInt(int64(n)) {
+			dst.SetInt(int64(n))
+		} else if dst.CanUint() && !dst.OverflowUint(n) {
+			dst.SetUint(n)
+		} else {
+			return v, false
+		}
+	case src.CanFloat():
+		f := src.Float()
+		if f != math.Trunc(f) || math.IsInf(f, 0) {
+			return v, false
+		}
+		// the range of int64 and uint64 is checked before converting, since out of range conversions are undefined
+		if dst.CanInt() && f >= math.MinInt64 && f < math.MaxInt64 && !dst.OverflowInt(int64(f)) {
+			dst.SetInt(int64(f))
+		} else if dst.CanUint() && f >= 0 && f < math.MaxUint64 && !dst.OverflowUint(uint64(f)) {
+			dst.SetUint(uint64(f))
+		} else {
+			return v, false
+		}
+	default:
+		return v, false
+	}
+	return v, true
+}
+
+// formatDynamic formats x for error messages, quoting strings.
+func formatDynamic(x any) string {
+	if s, ok := x.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprintf("%v", x)
+}
@@ -0,0 +1,81 @@
+package enum_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleCoerce() {
+	var payload map[string]any
+	_ = json.Unmarshal([]byte(`{"status": "open", "access": 4, "other": 2.5}`), &payload)
+
+	fmt.Println(enum.Coerce[Status](payload["status"]))
+	fmt.Println(enum.Coerce[Access](payload["access"]))
+	fmt.Println(enum.Coerce[Access](payload["other"]))
+	fmt.Println(enum.Coerce[Access](payload["status"]))
+	fmt.Println(enum.Coerce[Access](3))
+
+	// Output:
+	// open <nil>
+	// 4 <nil>
+	// 0 enum: type mismatch: can't convert float64 2.5 to Access
+	// 0 enum: type mismatch: can't convert string "open" to Access
+	// 0 3 is not a valid choice, allowed values are: 1, 2, 4
+}
+
+func TestCoerce(t *testing.T) {
+	type Small int8
+	type Big uint64
+	enum.DefAll[Small](-1, 1, 100)
+	enum.DefAll[Big](1, math.MaxUint64)
+
+	valid := []struct {
+		x    any
+		want any
+	}{
+		{int64(-1), Small(-1)},
+		{uint8(100), Small(100)},
+		{-1.0, Small(-1)},
+		{json.Number("1"), Small(1)},
+		{Access(1), Small(1)},
+		{uint64(math.MaxUint64), Big(math.MaxUint64)},
+		{json.Number("18446744073709551615"), Big(math.MaxUint64)},
+		{float32(1), Big(1)},
+		{json.Number("open"), StatusOpen},
+		{Status("open"), StatusOpen},
+	}
+	for _, tc := range valid {
+		var got any
+		var err error
+		switch tc.want.(type) {
+		case Small:
+			got, err = enum.Coerce[Small](tc.x)
+		case Big:
+			got, err = enum.Coerce[Big](tc.x)
+		case Status:
+			got, err = enum.Coerce[Status](tc.x)
+		}
+		if err != nil || got != tc.want {
+			t.Errorf("Coerce(%T %v) = %v, %v, want %v", tc.x, tc.x, got, err, tc.want)
+		}
+	}
+
+	for _, x := range []any{nil, 300, 1.5, math.Inf(1), 1e20, json.Number("1.0"), "1", true, []int{1}} {
+		if _, err := enum.Coerce[Small](x); !errors.Is(err, enum.ErrTypeMismatch) {
+			t.Errorf("Coerce[Small](%T %v) = %v, want ErrTypeMismatch", x, x, err)
+		}
+	}
+	for _, x := range []any{-1, int8(-1), -1.0, 1e20, json.Number("-1")} {
+		if _, err := enum.Coerce[Big](x); !errors.Is(err, enum.ErrTypeMismatch) {
+			t.Errorf("Coerce[Big](%T %v) = %v, want ErrTypeMismatch", x, x, err)
+		}
+	}
+	if _, err := enum.Coerce[Small](2); !errors.Is(err, enum.ErrInvalidValue) {
+		t.Errorf("Coerce[Small](2) = %v, want ErrInvalidValue", err)
+	}
+}
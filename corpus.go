@@ -0,0 +1,91 @@
+package enum
+
+import (
+	"math"
+	"reflect"
+	"slices"
+)
+
+// Corpus returns defined values of enum T, in the same order as ValuesOf, and samples of values that are not defined,
+// e.g. for seeding fuzz tests via testing.F.Add, or for table-driven tests.
+// Invalid samples of integer enums are neighbours of the smallest and the largest values, zero, and bounds of T,
+// the ones that don't fit T are skipped. Invalid samples of string enums are the empty string and generated strings.
+// Invalid samples are best-effort: values which are defined or aliased are left out, so they may be empty,
+// e.g. if all values of ~uint8 are defined. It is safe to modify the returned slices.
+// Usage:
+//   func FuzzParseStatus(f *testing.F) {
+//     valid, invalid := enum.Corpus[Status]()
+//     for _, v := range append(valid, invalid...) {
+//       f.Add(string(v))
+//     }
+//     f.Fuzz(func(t *testing.T, s string) { ... })
+//   }
+func Corpus[T enumType]() (valid []T, invalidSamples []T) {
+	typ := idOf[T]()
+	mu.RLock()
+	defer mu.RUnlock()
+	vals, _ := groups[typ].([]T)
+	valid = slices.Clone(withoutZero(typ, vals))
+
+	var zero T
+	candidates := []T{zero}
+	if typ.Kind() == reflect.String {
+		s := "invalid"
+		for {
+			v, _ := fromString[T](s)
+			if _, ok := canonical(typ, v); !ok {
+				candidates = append(candidates, v)
+				break
+			}
+			s += "_"
+		}
+		for _, v := range vals {
+			candidates = append(candidates, v+v)
+		}
+	} else {
+		if sortedVals := sorted[T](typ); len(sortedVals) > 0 {
+			candidates = appendShifted(candidates, sortedVals[0], -1)
+			candidates = appendShifted(candidates, sortedVals[len(sortedVals)-1], 1)
+		}
+		lo, hi := bounds[T]()
+		candidates = append(candidates, lo, hi)
+	}
+	for _, v := range candidates {
+		if _, ok := canonical(typ, v); !ok && !slices.Contains(invalidSamples, v) {
+			invalidSamples = append(invalidSamples, v)
+		}
+	}
+	return valid, invalidSamples
+}
+
+// appendShifted appends v+delta to dst, where delta is 1 or -1, unless it doesn't fit integer enum T.
+func appendShifted[T enumType](dst []T, v T, delta int64) []T {
+	rv := reflect.ValueOf(&v).Elem()
+	if rv.CanInt() {
+		n := rv.Int()
+		if (delta > 0 && n == math.MaxInt64) || (delta < 0 && n == math.MinInt64) || rv.OverflowInt(n+delta) {
+			return dst
+		}
+		rv.SetInt(n + delta)
+	} else {
+		n := rv.Uint()
+		if (delta > 0 && n == math.MaxUint64) || (delta < 0 && n == 0) || rv.OverflowUint(n+uint64(delta)) {
+			return dst
+		}
+		rv.SetUint(n + uint64(delta))
+	}
+	return append(dst, v)
+}
+
+// bounds returns the smallest and the largest values of integer enum T.
+func bounds[T enumType]() (lo, hi T) {
+	rv := reflect.ValueOf(&hi).Elem()
+	bits := rv.Type().Bits()
+	if rv.CanInt() {
+		rv.SetInt(math.MaxInt64 >> (64 - bits))
+		reflect.ValueOf(&lo).Elem().SetInt(math.MinInt64 >> (64 - bits))
+	} else {
+		rv.SetUint(math.MaxUint64 >> (64 - bits))
+	}
+	return lo, hi
+}
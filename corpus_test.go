@@ -0,0 +1,48 @@
+package enum_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleCorpus() {
+	fmt.Println(enum.Corpus[Access]())
+	valid, invalid := enum.Corpus[Status]()
+	fmt.Printf("%q %q\n", valid, invalid)
+
+	// Output:
+	// [1 2 4] [0 5 -9223372036854775808 9223372036854775807]
+	// ["draft" "open" "merged" "closed"] ["" "invalid" "draftdraft" "openopen" "mergedmerged" "closedclosed"]
+}
+
+func TestCorpus(t *testing.T) {
+	type Small int8
+	enum.DefAll[Small](math.MinInt8, 0, math.MaxInt8)
+	type Byte uint8
+	for i := range 256 {
+		enum.Def(Byte(i))
+	}
+	type Word string
+	enum.DefAll[Word]("", "invalid", "invalid_")
+
+	_, small := enum.Corpus[Small]()
+	if len(small) != 0 {
+		t.Errorf("invalid samples of Small = %v, want none", small)
+	}
+	valid, bytes := enum.Corpus[Byte]()
+	if len(valid) != 256 || len(bytes) != 0 {
+		t.Errorf("Corpus[Byte]() = %d valid, %v invalid, want 256 and none", len(valid), bytes)
+	}
+	_, words := enum.Corpus[Word]()
+	for _, w := range words {
+		if enum.IsValid(w) {
+			t.Errorf("invalid sample %q is valid", w)
+		}
+	}
+	if len(words) == 0 {
+		t.Error("got no invalid samples of Word")
+	}
+}
@@ -0,0 +1,50 @@
+package enum
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ValidateCSVColumn reads the remaining records of r and parses field col of each as enum T, see Parse.
+// Returns errors prefixed with line numbers, empty fields are reported with errors wrapping ErrEmptyValue,
+// so that the caller can tell them from undefined values. Malformed records are reported as well.
+// Stops after limit errors, unless limit is not positive. Read the header, if any, before calling it.
+// Usage:
+//   r := csv.NewReader(f)
+//   r.Read() // skip header
+//   for _, err := range enum.ValidateCSVColumn[Status](r, 2, 100) {
+//     log.Print(err)
+//   }
+func ValidateCSVColumn[T enumType](r *csv.Reader, col, limit int) []error {
+	var errs []error
+	for limit <= 0 || len(errs) < limit {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			errs = append(errs, err)
+			continue
+		}
+		if err != nil {
+			return append(errs, err)
+		}
+		line, _ := r.FieldPos(0)
+		if col < 0 || col >= len(record) {
+			errs = append(errs, fmt.Errorf("line %d: no column %d", line, col))
+			continue
+		}
+		line, _ = r.FieldPos(col)
+		if record[col] == "" {
+			errs = append(errs, fmt.Errorf("line %d: column %d: %w", line, col, ErrEmptyValue))
+			continue
+		}
+		if _, err := Parse[T](record[col]); err != nil {
+			errs = append(errs, fmt.Errorf("line %d: column %d: %w", line, col, err))
+		}
+	}
+	return errs
+}
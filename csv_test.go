@@ -0,0 +1,55 @@
+package enum_test
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleValidateCSVColumn() {
+	r := csv.NewReader(strings.NewReader(`id,title,status,access
+1,Fix typo,merged,4
+2,Add docs,,1
+3,Refactor,postponed,2
+4,"Bump deps
+and tidy",closed,3
+`))
+	r.Read() // skip header
+	for _, err := range enum.ValidateCSVColumn[Status](r, 2, 0) {
+		fmt.Println(err)
+	}
+	// Output:
+	// line 3: column 2: enum: empty value
+	// line 4: column 2: "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+}
+
+func TestValidateCSVColumn(t *testing.T) {
+	input := "4\n3\n\n5\n1,2\n9\n"
+	r := csv.NewReader(strings.NewReader(input))
+	r.FieldsPerRecord = -1
+	errs := enum.ValidateCSVColumn[Access](r, 0, 0)
+	if len(errs) != 3 {
+		t.Fatalf("got %d errors, want 3: %v", len(errs), errs)
+	}
+	for _, err := range errs {
+		if !errors.Is(err, enum.ErrInvalidValue) {
+			t.Errorf("got %v, want ErrInvalidValue", err)
+		}
+	}
+
+	r = csv.NewReader(strings.NewReader(input))
+	r.FieldsPerRecord = -1
+	if errs := enum.ValidateCSVColumn[Access](r, 0, 2); len(errs) != 2 {
+		t.Errorf("got %d errors, want 2 for limit 2: %v", len(errs), errs)
+	}
+
+	r = csv.NewReader(strings.NewReader("1,2\n4\n"))
+	errs = enum.ValidateCSVColumn[Access](r, 1, 0)
+	if len(errs) != 1 {
+		t.Errorf("got %d errors, want 1 for malformed record: %v", len(errs), errs)
+	}
+}
@@ -0,0 +1,59 @@
+package enum
+
+import "fmt"
+
+// keys are always typeValue[enumType], values are replacements given in DefDeprecated.
+var deprecations = map[any]any{}
+
+// DefDeprecated defines v as a valid but deprecated value of enum T and returns it.
+// Deprecated values are accepted as usual, except by ValidateStrict, replacement is suggested instead of them.
+// Replacement must be defined, otherwise it panics, as well as if v is already deprecated in favor of another value.
+// Usage:
+//   var (
+//     StatusInProgress = enum.Def[Status]("in_progress")
+//     StatusWIP        = enum.DefDeprecated[Status]("wip", StatusInProgress)
+//   )
+func DefDeprecated[T enumType](v, replacement T) T {
+	typID := idOf[T]()
+	vKey := typeValue[T]{val: v, typ: typID}
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := defs[typeValue[T]{val: replacement, typ: typID}]; !ok {
+		panic(fmt.Sprintf("%s: can't deprecate %s in favor of undefined %s", typID.Name(), toString(v), toString(replacement)))
+	}
+	if old, ok := deprecations[vKey]; ok && old.(T) != replacement {
+		panic(fmt.Sprintf("%s: can't deprecate %s in favor of %s, it is already deprecated in favor of %s",
+			typID.Name(), toString(v), toString(replacement), toString(old.(T))))
+	}
+	def(typID, v)
+	deprecations[vKey] = replacement
+	return v
+}
+
+// IsDeprecated returns the replacement of v given in DefDeprecated.
+// Reports false if v is not deprecated.
+func IsDeprecated[T enumType](v T) (T, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	replacement, ok := deprecations[typeValue[T]{typ: idOf[T](), val: v}]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return replacement.(T), true
+}
+
+// ValidateStrict is like Validate but also rejects deprecated values, with an error wrapping ErrDeprecated.
+// It lets to accept deprecated values in one place, e.g. when reading stored data, while rejecting them in another.
+func ValidateStrict[T enumType](v T) error {
+	if err := Validate(v); err != nil {
+		return err
+	}
+	typ := idOf[T]()
+	mu.RLock()
+	defer mu.RUnlock()
+	if replacement, ok := deprecations[typeValue[T]{typ: typ, val: v}]; ok {
+		return fmt.Errorf("%w: %s, use %s instead", ErrDeprecated, formatValue(typ, v), formatValue(typ, replacement.(T)))
+	}
+	return nil
+}
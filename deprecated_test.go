@@ -0,0 +1,50 @@
+package enum_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleDefDeprecated() {
+	type Phase string
+	var (
+		PhaseInProgress = enum.Def[Phase]("in_progress")
+		PhaseWIP        = enum.DefDeprecated[Phase]("wip", PhaseInProgress)
+	)
+
+	fmt.Println(enum.IsValid(PhaseWIP), enum.Validate(PhaseWIP))
+	fmt.Println(enum.IsDeprecated(PhaseWIP))
+	fmt.Println(enum.IsDeprecated(PhaseInProgress))
+	fmt.Println(enum.ValidateStrict(PhaseWIP))
+	fmt.Println(enum.ValidateStrict(PhaseInProgress))
+	fmt.Println(enum.ValidateStrict[Phase]("done"))
+	// Output:
+	// true <nil>
+	// in_progress true
+	//  false
+	// enum: deprecated value: "wip", use "in_progress" instead
+	// <nil>
+	// "done" is not a valid choice, allowed values are: "in_progress", "wip"
+}
+
+func TestDefDeprecated(t *testing.T) {
+	type Level int
+	enum.DefAll[Level](1, 2)
+	enum.DefDeprecated[Level](0, 1)
+	enum.DefDeprecated[Level](0, 1) // same replacement again is fine
+	mustPanic(t, func() { enum.DefDeprecated[Level](0, 2) })
+	mustPanic(t, func() { enum.DefDeprecated[Level](3, 4) })
+	if enum.IsValid[Level](3) {
+		t.Error("value is defined despite the panic")
+	}
+	if err := enum.ValidateStrict[Level](0); !errors.Is(err, enum.ErrDeprecated) {
+		t.Errorf("ValidateStrict(0) = %v, want ErrDeprecated", err)
+	}
+	enum.Undef[Level](1)
+	if err := enum.ValidateStrict[Level](0); err != nil {
+		t.Errorf("ValidateStrict(0) = %v after its replacement is undefined", err)
+	}
+}
@@ -3,11 +3,11 @@
 package enum
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"reflect"
 	"slices"
-	"strings"
 	"sync"
 )
 
@@ -34,11 +34,35 @@ var mu sync.RWMutex
 
 // values are always slices of enumType, but can't be defined at compile time:
 // https://github.com/golang/go/issues/51338
+// Elements of the slices are never modified in place, so it is safe to read a slice after releasing mu.
 var groups = map[typeID]any{}
 
 // keys are always typeValue[enumType], but can't be defined at compile time:
 // https://github.com/golang/go/issues/51338
-var defs = map[any]struct{}{}
+// values are positions of definitions in their groups.
+var defs = map[any]int{}
+
+// typeName is used as composite key for looking up values by their names or labels.
+type typeName struct {
+	typ  typeID
+	name string
+}
+
+// keys are always typeValue[enumType], values are names given in DefNamed.
+var names = map[any]string{}
+
+// values are always enumType, reverse index of names.
+// Also holds alias names given in DefAliasName.
+var named = map[typeName]any{}
+
+// keys are always typeValue[enumType], values are canonical enumType values given in DefAlias.
+var aliases = map[any]any{}
+
+// validators are Validate[T] of each enum T with definitions, for validating values whose type is known only at runtime.
+var validators = map[typeID]func(v any) error{}
+
+// keys are types that had a definition since they were cleared, see IsRegistered.
+var registered = map[typeID]struct{}{}
 
 // Def defines v as a valid value of enum T and returns it.
 // Value is returned as-is, without any wrapping or conversion.
@@ -50,48 +74,363 @@ var defs = map[any]struct{}{}
 //     StatusOpen   = enum.Def[Status]("open") // same thing, alternative syntax
 //   )
 func Def[T enumType](v T) T {
+	typID := idOf[T]()
+	mu.Lock()
+	defer mu.Unlock()
+	def(typID, v)
+	return v
+}
+
+// DefAll defines each of vs as a valid value of enum T, in the given order, and returns vs.
+// It is equivalent to calling Def for each value, duplicates are ignored.
+// Usage:
+//   var allStatuses = enum.DefAll[Status]("draft", "open", "merged", "closed")
+func DefAll[T enumType](vs ...T) []T {
+	typID := idOf[T]()
+	mu.Lock()
+	defer mu.Unlock()
+	for _, v := range vs {
+		def(typID, v)
+	}
+	return vs
+}
+
+// DefUnique is like Def but returns an error if v is already defined for enum T, instead of ignoring it.
+// It catches copy-paste mistakes in large hand-maintained enums, where two constants share the same value.
+// Unlike Def, it returns an error rather than panics if enum T is frozen.
+// Usage:
+//   var (
+//     CurrencyUSD = must(enum.DefUnique[Currency]("USD"))
+//     CurrencyEUR = must(enum.DefUnique[Currency]("USD")) // returns an error
+//   )
+func DefUnique[T enumType](v T) (T, error) {
+	typID := idOf[T]()
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := defs[typeValue[T]{val: v, typ: typID}]; ok {
+		return v, fmt.Errorf("%s: %s is already defined", typID.Name(), formatValue(typID, v))
+	}
+	if err := checkFrozen(typID, func() string { return formatValue(typID, v) }); err != nil {
+		return v, err
+	}
+	def(typID, v)
+	return v, nil
+}
+
+// DefNamed defines v as a valid value of enum T with the given name and returns it.
+// Name is shown next to the value in error messages of integer enums, e.g. "read" (1).
+// Optional alias names are accepted by Parse along with the name, see DefAliasName.
+// Naming an already named value differently, or giving the same name to two values, panics.
+// Usage:
+//   type Access int
+//   var (
+//     AccessRead  = enum.DefNamed[Access](1, "read")
+//     AccessWrite = enum.DefNamed[Access](4, "write", "rw")
+//   )
+func DefNamed[T enumType](v T, name string, aliasNames ...string) T {
 	typID := idOf[T]()
 	vKey := typeValue[T]{val: v, typ: typID}
 	mu.Lock()
 	defer mu.Unlock()
+	if old, ok := names[vKey]; ok && old != name {
+		panic(fmt.Sprintf("%s: can't name %s %q, it is already named %q", typID.Name(), toString(v), name, old))
+	}
+	for _, n := range append([]string{name}, aliasNames...) {
+		old, ok := named[typeName{typ: typID, name: n}]
+		if ok && old.(T) != v {
+			panic(fmt.Sprintf("%s: can't name %s %q, the name is already taken by %s", typID.Name(), toString(v), n, toString(old.(T))))
+		}
+		if !ok {
+			if err := checkFrozen(typID, func() string { return fmt.Sprintf("name %q", n) }); err != nil {
+				panic(err.Error())
+			}
+		}
+	}
+	def(typID, v)
+	names[vKey] = name
+	changed(typID)
+	named[typeName{typ: typID, name: name}] = v
+	for _, n := range aliasNames {
+		named[typeName{typ: typID, name: n}] = v
+	}
+	return v
+}
+
+// DefAlias defines alias as an alternative spelling of already defined canonical value of enum T.
+// Alias is accepted by IsValid, Validate and Parse, the latter returns canonical value for it.
+// Alias is not listed by ValuesOf and error messages.
+// Returns an error if canonical isn't defined, or alias is a defined value or an alias of another value.
+func DefAlias[T enumType](canonical, alias T) error {
+	typID := idOf[T]()
+	aKey := typeValue[T]{val: alias, typ: typID}
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := defs[typeValue[T]{val: canonical, typ: typID}]; !ok {
+		return fmt.Errorf("%s: can't alias undefined %s", typID.Name(), formatValue(typID, canonical))
+	}
+	if _, ok := defs[aKey]; ok {
+		return fmt.Errorf("%s: can't alias defined %s", typID.Name(), formatValue(typID, alias))
+	}
+	old, ok := aliases[aKey]
+	if ok && old.(T) != canonical {
+		return fmt.Errorf("%s: %s is already an alias of %s", typID.Name(), formatValue(typID, alias), formatValue(typID, old.(T)))
+	}
+	if !ok {
+		if err := checkFrozen(typID, func() string { return "alias " + formatValue(typID, alias) }); err != nil {
+			return err
+		}
+	}
+	changed(typID)
+	aliases[aKey] = canonical
+	return nil
+}
+
+// DefAliasName is like DefAlias but defines alias as an alternative name of canonical value, see DefNamed.
+// Alias name is accepted by Parse only.
+func DefAliasName[T enumType](canonical T, alias string) error {
+	typID := idOf[T]()
+	nKey := typeName{typ: typID, name: alias}
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := defs[typeValue[T]{val: canonical, typ: typID}]; !ok {
+		return fmt.Errorf("%s: can't alias undefined %s", typID.Name(), formatValue(typID, canonical))
+	}
+	old, ok := named[nKey]
+	if ok && old.(T) != canonical {
+		return fmt.Errorf("%s: name %q is already taken by %s", typID.Name(), alias, formatValue(typID, old.(T)))
+	}
+	if !ok {
+		if err := checkFrozen(typID, func() string { return fmt.Sprintf("name %q", alias) }); err != nil {
+			return err
+		}
+	}
+	named[nKey] = canonical
+	return nil
+}
+
+// def registers v for enum typID, mu must be held for writing.
+func def[T enumType](typID typeID, v T) {
+	vKey := typeValue[T]{val: v, typ: typID}
 	if _, ok := defs[vKey]; ok {
-		return v // already defined
+		return // already defined
+	}
+	if err := checkFrozen(typID, func() string { return formatValue(typID, v) }); err != nil {
+		panic(err.Error())
 	}
-	defs[vKey] = struct{}{}
 	vals, _ := groups[typID].([]T)
+	defs[vKey] = len(vals)
 	groups[typID] = append(vals, v)
-	return v
+	validators[typID] = validateAny[T]
+	allowedFormatters[typID] = formatAll[T]
+	if typID.Kind() == reflect.String {
+		hinters[typID] = hintOf[T]
+	}
+	registered[typID] = struct{}{}
+	changed(typID)
+}
+
+// validateAny is Validate for v of dynamic type T.
+func validateAny[T enumType](v any) error {
+	return Validate(v.(T))
 }
 
 // IsValid reports whether v is defined for enum T.
 func IsValid[T enumType](v T) bool {
 	mu.RLock()
 	defer mu.RUnlock()
-	_, ok := defs[typeValue[T]{typ: idOf[T](), val: v}]
+	_, ok := canonical(idOf[T](), v)
 	return ok
 }
 
+// Contains reports whether v equals any of allowed.
+// Unlike IsValid, it doesn't look at definitions of enum T, so it doesn't take any lock nor allocate.
+// Usage:
+//   if enum.Contains(order.Status, OrderStatusPending, OrderStatusPaid) {
+//     cancel(order)
+//   }
+func Contains[T enumType](v T, allowed ...T) bool {
+	return slices.Contains(allowed, v)
+}
+
 // Validate checks whether v is defined for enum T.
-// If not, returns an error, otherwise returns nil.
+// If not, returns *ValidationError, otherwise returns nil.
+// For string enums, the message suggests the value v is likely a misspelling of, see ValidationError.Suggestion.
 func Validate[T enumType](v T) error {
-	// TODO cache error msg to avoid constructing it every time.
 	typ := idOf[T]()
 	mu.RLock()
 	defer mu.RUnlock()
-	_, valueExists := defs[typeValue[T]{typ: typ, val: v}]
+	_, valueExists := canonical(typ, v)
 	if !valueExists {
-		vals, enumExists := groups[typ]
-		if !enumExists {
-			return fmt.Errorf("%s doesn't have any definition", typ.Name())
+		return invalidErr(typ, v)
+	}
+	return nil
+}
+
+// ValidateValue is like Validate but for v whose enum type is known only at runtime,
+// e.g. a field reached by walking a struct with reflect. If v is reflect.Value, the value it holds is validated.
+// Returns an error wrapping ErrNoDefinitions if the dynamic type of v doesn't have any definition.
+func ValidateValue(v any) error {
+	if rv, ok := v.(reflect.Value); ok {
+		if !rv.IsValid() || !rv.CanInterface() {
+			return fmt.Errorf("enum: can't validate %v obtained from unexported field or zero reflect.Value", rv)
 		}
-		s, _ := vals.([]T)
-		fmtVerb := "%v"
-		if typ.Kind() == reflect.String {
-			fmtVerb = "%q" // use quotes for strings to visually distinguish them from integers
+		v = rv.Interface()
+	}
+	typ := reflect.TypeOf(v)
+	if typ == nil {
+		return fmt.Errorf("enum: can't validate nil: %w", ErrNoDefinitions)
+	}
+	mu.RLock()
+	validate, ok := validators[typ]
+	mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("enum: %s is not an enum with definitions: %w", typ, ErrNoDefinitions)
+	}
+	return validate(v)
+}
+
+// ValidateOptional is like Validate but for optional values, nil p is valid.
+func ValidateOptional[T enumType](p *T) error {
+	if p == nil {
+		return nil
+	}
+	return Validate(*p)
+}
+
+// ValidateRequired is like Validate but for values that may be missing,
+// nil p is reported with an error wrapping ErrRequired, rather than ErrInvalidValue.
+// Usage:
+//   if err := enum.ValidateRequired(req.Status); errors.Is(err, enum.ErrRequired) {
+//     http.Error(w, "status is required", http.StatusBadRequest)
+//   }
+func ValidateRequired[T enumType](p *T) error {
+	if p == nil {
+		return fmt.Errorf("%w: %s", ErrRequired, idOf[T]().Name())
+	}
+	return Validate(*p)
+}
+
+// MustValidate is like Validate but panics if v is not defined for enum T, otherwise returns v.
+// It is meant for invariants, startup code and tests, where an invalid value is a programming error.
+// The panic value is the *ValidationError returned by Validate, so that recovering code can inspect it.
+// Usage:
+//   status := enum.MustValidate(cfg.Status)
+func MustValidate[T enumType](v T) T {
+	if err := Validate(v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// ValidateOrDefault returns v if it is defined for enum T, otherwise fallback, e.g. for lenient parsing of configs.
+// Unlike ParseOrDefault, fallback is checked too: it panics if fallback is not defined,
+// so that an invalid value can't be replaced by another one.
+// Usage:
+//   status := enum.ValidateOrDefault(cfg.Status, StatusDraft)
+func ValidateOrDefault[T enumType](v, fallback T) T {
+	v, _ = ValidateOrDefaultOK(v, fallback)
+	return v
+}
+
+// ValidateOrDefaultOK is like ValidateOrDefault but also reports whether v is defined, i.e. false if fallback is returned.
+// Usage:
+//   status, ok := enum.ValidateOrDefaultOK(cfg.Status, StatusDraft)
+//   if !ok {
+//     log.Printf("unknown status %q, using %q", cfg.Status, status)
+//   }
+func ValidateOrDefaultOK[T enumType](v, fallback T) (T, bool) {
+	typ := idOf[T]()
+	mu.RLock()
+	_, valid := canonical(typ, v)
+	var err error
+	if _, ok := canonical(typ, fallback); !ok {
+		err = invalidErr(typ, fallback)
+	}
+	mu.RUnlock()
+	if err != nil {
+		panic(fmt.Sprintf("enum: ValidateOrDefault[%s]: fallback: %v", typ.Name(), err))
+	}
+	if !valid {
+		return fallback, false
+	}
+	return v, true
+}
+
+// ValidateAll checks whether each of vs is defined for enum T.
+// Returns nil if all of them are valid, otherwise errors of Validate prefixed with indexes of invalid values,
+// joined by errors.Join. If enum T doesn't have any definition, returns a single error instead of one per value.
+// Usage:
+//   if err := enum.ValidateAll(req.Statuses...); err != nil {
+//     http.Error(w, err.Error(), http.StatusBadRequest)
+//   }
+func ValidateAll[T enumType](vs ...T) error {
+	return validateSlice(vs, false)
+}
+
+// ValidateMany is the same as ValidateAll, it checks loose values of enum T under a single lock.
+// Usage:
+//   if err := enum.ValidateMany(filter, sortKey, status); err != nil {
+//     http.Error(w, err.Error(), http.StatusBadRequest)
+//   }
+func ValidateMany[T enumType](vs ...T) error {
+	return validateSlice(vs, false)
+}
+
+// ValidateSlice is the same as ValidateAll, for callers that already have a slice.
+func ValidateSlice[T enumType](vs []T) error {
+	return validateSlice(vs, false)
+}
+
+// ValidateSliceFirst is like ValidateSlice but stops at the first invalid value and returns only its error.
+// It is cheaper for hot paths, where any invalid value rejects the whole slice.
+func ValidateSliceFirst[T enumType](vs []T) error {
+	return validateSlice(vs, true)
+}
+
+// validateSlice implements ValidateAll and its variants, it stops at the first invalid value if failFast is true.
+func validateSlice[T enumType](vs []T, failFast bool) error {
+	typ := idOf[T]()
+	mu.RLock()
+	if _, enumExists := groups[typ]; !enumExists && len(vs) > 0 {
+		defer mu.RUnlock()
+		return invalidErr(typ, vs[0])
+	}
+	var invalid []int
+	var errs []error
+	for i, v := range vs {
+		if _, ok := canonical(typ, v); !ok {
+			invalid = append(invalid, i)
+			errs = append(errs, invalidErr(typ, v))
+			if failFast {
+				break
+			}
 		}
-		return errors.New(errMsg(fmtVerb, v, s))
 	}
-	return nil
+	mu.RUnlock()
+	// errors are prefixed after releasing the lock, since Error of ValidationError takes it
+	for i, err := range errs {
+		errs[i] = fmt.Errorf("index %d: %w", invalid[i], err)
+	}
+	if failFast && len(errs) > 0 {
+		return errs[0]
+	}
+	return errors.Join(errs...)
+}
+
+// canonical returns v if it is defined for enum typ, or the value it is an alias of.
+// Reports false if v is neither defined nor aliased, or it is a zero value given in DisallowZero, mu must be held.
+func canonical[T enumType](typ typeID, v T) (T, bool) {
+	if isDisallowedZero(typ, v) {
+		return v, false
+	}
+	vKey := typeValue[T]{typ: typ, val: v}
+	if _, ok := defs[vKey]; ok {
+		return v, true
+	}
+	if c, ok := aliases[vKey]; ok {
+		return c.(T), true
+	}
+	return v, false
 }
 
 // ValuesOf returns defined values of enum T.
@@ -100,38 +439,177 @@ func Validate[T enumType](v T) error {
 func ValuesOf[T enumType]() []T {
 	mu.RLock()
 	defer mu.RUnlock()
-	if vals, ok := groups[idOf[T]()]; ok {
-		return slices.Clone(vals.([]T))
+	typ := idOf[T]()
+	if vals, ok := groups[typ]; ok {
+		return slices.Clone(withoutZero(typ, vals.([]T)))
 	}
 	return nil
 }
 
+// Count returns the number of defined values of enum T.
+// It is a cheaper alternative to len(ValuesOf[T]()).
+func Count[T enumType]() int {
+	mu.RLock()
+	defer mu.RUnlock()
+	typ := idOf[T]()
+	vals, _ := groups[typ].([]T)
+	return len(withoutZero(typ, vals))
+}
+
+// IsRegistered reports whether enum T had a definition, even if all values were removed via Undef since then.
+// Unlike Count, it tells apart enums that were never set up, or were reset via Clear.
+func IsRegistered[T enumType]() bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	_, ok := registered[idOf[T]()]
+	return ok
+}
+
+// ListTypes returns all enum types with definitions, sorted by name.
+// Types of the same name, e.g. declared in different packages, are sorted by their package paths.
+// It is safe to modify the returned slice.
+func ListTypes() []reflect.Type {
+	mu.RLock()
+	types := make([]reflect.Type, 0, len(groups))
+	for typ := range groups {
+		types = append(types, typ)
+	}
+	mu.RUnlock()
+	slices.SortFunc(types, func(a, b reflect.Type) int {
+		return cmp.Or(cmp.Compare(a.Name(), b.Name()), cmp.Compare(a.PkgPath(), b.PkgPath()), cmp.Compare(a.String(), b.String()))
+	})
+	return types
+}
+
 // idOf returns unique typeID for each T without instantiating.
 func idOf[T enumType]() typeID {
 	return reflect.TypeOf((*T)(nil)).Elem()
 }
 
-func errMsg[T enumType](fmtVerb string, invalidVal T, vals []T) string {
-	sb := strings.Builder{}
-	sb.WriteString(fmt.Sprintf(fmtVerb+" is not a valid choice, allowed values are: ", invalidVal))
-	// vals are guaranteed to be non-empty for defined enums
-	sb.WriteString(fmt.Sprintf(fmtVerb, vals[0]))
-	tail := ", " + fmtVerb
-	for _, v := range vals[1:] {
-		sb.WriteString(fmt.Sprintf(tail, v))
+// Undef removes definition of v for enum T, including its name, alias names, aliases, label, metadata
+// and deprecations of it or in favor of it, and reports whether v was defined. Values deprecated in favor of v stay defined. Order of the remaining values is preserved.
+// If v was the last value, enum T doesn't have any definition anymore, but its settings are kept, see Clear.
+// Undefining a value of a frozen enum panics.
+func Undef[T enumType](v T) bool {
+	typID := idOf[T]()
+	vKey := typeValue[T]{val: v, typ: typID}
+	mu.Lock()
+	defer mu.Unlock()
+	pos, ok := defs[vKey]
+	if !ok {
+		return false
+	}
+	if _, ok := frozen[typID]; ok {
+		panic(fmt.Sprintf("%s: can't undefine %s, enum is frozen", typID.Name(), formatValue(typID, v)))
 	}
-	return sb.String()
+	// a new slice is allocated, since elements of groups are never modified in place
+	vals := slices.Delete(slices.Clone(groups[typID].([]T)), pos, pos+1)
+	for i, rest := range vals[pos:] {
+		defs[typeValue[T]{val: rest, typ: typID}] = pos + i
+	}
+	delete(defs, vKey)
+	changed(typID)
+	if len(vals) > 0 {
+		groups[typID] = vals
+	} else {
+		delete(groups, typID)
+		delete(validators, typID)
+		delete(allowedFormatters, typID)
+		delete(hinters, typID)
+	}
+	delete(names, vKey)
+	for k, namedV := range named {
+		if k.typ == typID && namedV.(T) == v {
+			delete(named, k)
+		}
+	}
+	for k, c := range aliases {
+		if a, ok := k.(typeValue[T]); ok && a.typ == typID && c.(T) == v {
+			delete(aliases, k)
+		}
+	}
+	delete(labels, vKey)
+	delete(metas, vKey)
+	for k, replacement := range deprecations {
+		if d, ok := k.(typeValue[T]); ok && d.typ == typID && (d.val == v || replacement.(T) == v) {
+			delete(deprecations, k)
+		}
+	}
+	for k, labeledV := range labeled {
+		if k.typ == typID && labeledV.(T) == v {
+			delete(labeled, k)
+		}
+	}
+	for k, labeledV := range labeledFold {
+		if k.typ == typID && labeledV.(T) == v {
+			delete(labeledFold, k)
+		}
+	}
+	return true
 }
 
-// Clear removes all definitions for enum T.
+// Clear removes all definitions for enum T, including their names, aliases, labels and metadata, and resets its settings.
 func Clear[T enumType]() {
 	mu.Lock()
 	defer mu.Unlock()
 	typID := idOf[T]()
 	delete(groups, typID)
+	delete(validators, typID)
+	delete(allowedFormatters, typID)
+	delete(hinters, typID)
+	changed(typID)
 	for k := range defs {
 		if v, ok := k.(typeValue[T]); ok && v.typ == typID {
 			delete(defs, k)
 		}
 	}
+	for k := range names {
+		if v, ok := k.(typeValue[T]); ok && v.typ == typID {
+			delete(names, k)
+		}
+	}
+	for k := range named {
+		if k.typ == typID {
+			delete(named, k)
+		}
+	}
+	for k := range aliases {
+		if v, ok := k.(typeValue[T]); ok && v.typ == typID {
+			delete(aliases, k)
+		}
+	}
+	for k := range labels {
+		if v, ok := k.(typeValue[T]); ok && v.typ == typID {
+			delete(labels, k)
+		}
+	}
+	for k := range metas {
+		if v, ok := k.(typeValue[T]); ok && v.typ == typID {
+			delete(metas, k)
+		}
+	}
+	for k := range deprecations {
+		if v, ok := k.(typeValue[T]); ok && v.typ == typID {
+			delete(deprecations, k)
+		}
+	}
+	for k := range labeled {
+		if k.typ == typID {
+			delete(labeled, k)
+		}
+	}
+	for k := range labeledFold {
+		if k.typ == typID {
+			delete(labeledFold, k)
+		}
+	}
+	delete(noSuggest, typID)
+	delete(formatters, typID)
+	delete(jsonLabels, typID)
+	delete(errorLimits, typID)
+	delete(errorFuncs, typID)
+	delete(frozen, typID)
+	delete(zeroDisallowed, typID)
+	delete(registered, typID)
+	delete(sortedErrors, typID)
 }
@@ -1,7 +1,12 @@
 package enum_test
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/0xcafe-io/enum"
@@ -122,6 +127,340 @@ func Example_scope() {
 	// in_progress is not a valid user status
 }
 
+func Example_named() {
+	type Permission int
+	var (
+		PermissionRead  = enum.DefNamed[Permission](1, "read")
+		PermissionWrite = enum.DefNamed[Permission](4, "write")
+		PermissionAdmin = enum.Def[Permission](8)
+	)
+
+	fmt.Println(PermissionRead, PermissionWrite, PermissionAdmin)
+	fmt.Println(enum.ValuesOf[Permission]())
+
+	if err := enum.Validate[Permission](3); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// 1 4 8
+	// [1 4 8]
+	// 3 is not a valid choice, allowed values are: "read" (1), "write" (4), 8
+}
+
+func ExampleDefAll() {
+	type Weekday string
+	weekdays := enum.DefAll[Weekday]("mon", "tue", "wed", "mon")
+	enum.Def[Weekday]("thu")
+
+	fmt.Println(weekdays)
+	fmt.Println(enum.ValuesOf[Weekday]())
+	// Output:
+	// [mon tue wed mon]
+	// [mon tue wed thu]
+}
+
+func ExampleDefUnique() {
+	type Currency string
+	fmt.Println(enum.DefUnique[Currency]("USD"))
+	fmt.Println(enum.DefUnique[Currency]("EUR"))
+	fmt.Println(enum.DefUnique[Currency]("USD"))
+	fmt.Println(enum.ValuesOf[Currency]())
+
+	enum.Freeze[Currency]()
+	_, err := enum.DefUnique[Currency]("JPY")
+	msg, _, _ := strings.Cut(err.Error(), " (called at") // position of the caller varies, see TestFrozenCallerPos
+	fmt.Println(msg)
+	// Output:
+	// USD <nil>
+	// EUR <nil>
+	// USD Currency: "USD" is already defined
+	// [USD EUR]
+	// Currency: can't define "JPY", enum is frozen
+}
+
+func ExampleCount() {
+	type Nothing int
+	fmt.Println(enum.Count[Status](), enum.Count[Access](), enum.Count[Nothing]())
+	// Output:
+	// 4 3 0
+}
+
+func ExampleContains() {
+	status := StatusOpen
+	fmt.Println(enum.Contains(status, StatusDraft, StatusOpen))
+	fmt.Println(enum.Contains(status, StatusMerged, StatusClosed))
+	fmt.Println(enum.Contains(status))
+	// Output:
+	// true
+	// false
+	// false
+}
+
+func TestContainsAllocs(t *testing.T) {
+	status := StatusMerged
+	allocs := testing.AllocsPerRun(100, func() {
+		enum.Contains(status, StatusDraft, StatusOpen, StatusMerged)
+	})
+	if allocs != 0 {
+		t.Errorf("Contains allocates %v times", allocs)
+	}
+}
+
+func TestListTypes(t *testing.T) {
+	type Zone string
+	type Unlisted string
+	enum.Def[Zone]("eu")
+	enum.Clear[Unlisted]()
+
+	types := enum.ListTypes()
+	for _, want := range []reflect.Type{reflect.TypeFor[Status](), reflect.TypeFor[Access](), reflect.TypeFor[Zone]()} {
+		if !slices.Contains(types, want) {
+			t.Errorf("ListTypes() = %v, missing %v", types, want)
+		}
+	}
+	if slices.Contains(types, reflect.TypeFor[Unlisted]()) {
+		t.Errorf("ListTypes() = %v, lists type without definitions", types)
+	}
+	if !slices.IsSortedFunc(types, func(a, b reflect.Type) int { return strings.Compare(a.Name(), b.Name()) }) {
+		t.Errorf("ListTypes() = %v, not sorted by name", types)
+	}
+}
+
+func ExampleValidateRequired() {
+	merged, postponed := StatusMerged, Status("postponed")
+	for _, p := range []*Status{&merged, &postponed, nil} {
+		fmt.Println(enum.ValidateOptional(p))
+		fmt.Println(enum.ValidateRequired(p))
+	}
+	fmt.Println(errors.Is(enum.ValidateRequired[Access](nil), enum.ErrRequired))
+	// Output:
+	// <nil>
+	// <nil>
+	// "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// <nil>
+	// enum: value is required: Status
+	// true
+}
+
+func ExampleMustValidate() {
+	status := enum.MustValidate(Status("open"))
+	fmt.Println(status)
+
+	defer func() {
+		r := recover()
+		fmt.Println(r)
+		if ve, ok := r.(*enum.ValidationError); ok {
+			fmt.Println(ve.TypeName(), ve.Value())
+		}
+	}()
+	enum.MustValidate[Access](3)
+
+	// Output:
+	// open
+	// 3 is not a valid choice, allowed values are: 1, 2, 4
+	// Access 3
+}
+
+func ExampleSetErrorLimit() {
+	type Currency string
+	enum.DefAll[Currency]("USD", "EUR", "JPY", "GBP", "CNY", "AUD", "CAD", "CHF", "HKD", "SGD", "SEK", "KRW")
+	fmt.Println(enum.Validate[Currency]("usd"))
+
+	enum.SetErrorLimit[Currency](3)
+	err := enum.Validate[Currency]("usd")
+	_, allowed, _ := enum.AsValidationError[Currency](err)
+	fmt.Println(err)
+	fmt.Println(len(allowed))
+
+	enum.SetErrorLimit[Currency](12)
+	fmt.Println(enum.Validate[Currency]("usd"))
+	// Output:
+	// "usd" is not a valid choice, allowed values are: "USD", "EUR", "JPY", "GBP", "CNY", "AUD", "CAD", "CHF", "HKD", "SGD", … and 2 more
+	// "usd" is not a valid choice, allowed values are: "USD", "EUR", "JPY", … and 9 more
+	// 12
+	// "usd" is not a valid choice, allowed values are: "USD", "EUR", "JPY", "GBP", "CNY", "AUD", "CAD", "CHF", "HKD", "SGD", "SEK", "KRW"
+}
+
+func ExampleValidateAll() {
+	fmt.Println(enum.ValidateAll(StatusDraft, StatusClosed))
+	fmt.Println(enum.ValidateAll[Status]("open", "postponed", "merged", "rejected"))
+
+	type Nothing int
+	fmt.Println(enum.ValidateAll[Nothing](1, 2, 3))
+	// Output:
+	// <nil>
+	// index 1: "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// index 3: "rejected" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// Nothing doesn't have any definition
+}
+
+func ExampleValidateMany() {
+	fmt.Println(enum.ValidateMany[Access](1, 3, 4, 8))
+	// Output:
+	// index 1: 3 is not a valid choice, allowed values are: 1, 2, 4
+	// index 3: 8 is not a valid choice, allowed values are: 1, 2, 4
+}
+
+func ExampleValidateSlice() {
+	statuses := []Status{"open", "postponed", "merged", "rejected"}
+	fmt.Println(enum.ValidateSlice(statuses))
+	fmt.Println(enum.ValidateSliceFirst(statuses))
+	fmt.Println(enum.ValidateSliceFirst(statuses[:1]))
+	// Output:
+	// index 1: "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// index 3: "rejected" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// index 1: "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// <nil>
+}
+
+func TestValidateAll(t *testing.T) {
+	if err := enum.ValidateAll[Access](); err != nil {
+		t.Errorf("ValidateAll() = %v", err)
+	}
+	err := enum.ValidateAll[Access](1, 3, 5)
+	if !errors.Is(err, enum.ErrInvalidValue) {
+		t.Errorf("ValidateAll(1, 3, 5) = %v, want ErrInvalidValue", err)
+	}
+	if v, _, ok := enum.AsValidationError[Access](err); !ok || v != 3 {
+		t.Errorf("ValidateAll(1, 3, 5) = %v, want first ValidationError for 3", err)
+	}
+}
+
+func ExampleUndef() {
+	type Color string
+	enum.DefAll[Color]("red", "green", "blue", "black")
+	_ = enum.DefAlias[Color]("green", "lime")
+
+	fmt.Println(enum.Undef[Color]("green"), enum.Undef[Color]("green"))
+	fmt.Println(enum.ValuesOf[Color](), enum.IsValid[Color]("green"), enum.IsValid[Color]("lime"))
+	fmt.Println(enum.Ordinal[Color]("blue"))
+	fmt.Println(enum.Validate[Color]("green"))
+	// Output:
+	// true false
+	// [red blue black] false false
+	// 1 true
+	// "green" is not a valid choice, allowed values are: "red", "blue", "black"
+}
+
+func TestUndef(t *testing.T) {
+	t.Cleanup(enum.Snapshot())
+	type Level int
+	enum.DefNamed[Level](1, "low", "minor")
+	enum.DefLabeled[Level](1, "Low")
+	if !enum.Undef[Level](1) {
+		t.Fatal("Undef(1) = false")
+	}
+	if _, err := enum.Parse[Level]("minor"); !errors.Is(err, enum.ErrNoDefinitions) {
+		t.Errorf("Parse(minor) = %v, want ErrNoDefinitions", err)
+	}
+	if _, ok := enum.FromLabel[Level]("Low"); ok {
+		t.Error("label is defined after Undef")
+	}
+	enum.DefNamed[Level](2, "low") // name is free again
+	enum.Freeze[Level]()
+	mustPanic(t, func() { enum.Undef[Level](2) })
+	if enum.Undef[Level](3) {
+		t.Error("Undef(3) = true for undefined value")
+	}
+}
+
+func TestDefNamedConflict(t *testing.T) {
+	type Level int
+	enum.DefNamed[Level](1, "low")
+	enum.DefNamed[Level](1, "low") // same name again is fine
+
+	mustPanic(t, func() { enum.DefNamed[Level](1, "minor") })
+	mustPanic(t, func() { enum.DefNamed[Level](2, "low") })
+
+	enum.DefNamed[Level](3, "high", "top", "max")
+	mustPanic(t, func() { enum.DefNamed[Level](4, "critical", "top") })
+	mustPanic(t, func() { enum.DefNamed[Level](4, "critical", "low") })
+	if enum.IsValid[Level](4) {
+		t.Error("value is defined despite the panic")
+	}
+}
+
+func ExampleDefNamed_aliases() {
+	type Permission int
+	PermissionRead := enum.DefNamed[Permission](1, "read", "r")
+	PermissionWrite := enum.DefNamed[Permission](4, "write", "rw", "w")
+
+	for _, s := range []string{"write", "rw", "w", "r"} {
+		fmt.Println(enum.Parse[Permission](s))
+	}
+	fmt.Println(enum.NameOf(PermissionRead))
+	fmt.Println(enum.NameOf(PermissionWrite))
+	fmt.Println(enum.Validate[Permission](2))
+	// Output:
+	// 4 <nil>
+	// 4 <nil>
+	// 4 <nil>
+	// 1 <nil>
+	// read true
+	// write true
+	// 2 is not a valid choice, allowed values are: "read" (1), "write" (4)
+}
+
+func TestValidateCachedMessage(t *testing.T) {
+	t.Cleanup(enum.Snapshot())
+	type Level int
+	enum.Def[Level](1)
+	check := func(want string) {
+		t.Helper()
+		if err := enum.Validate[Level](9); err == nil || err.Error() != want {
+			t.Errorf("Validate(9) = %v, want %s", err, want)
+		}
+	}
+	check("9 is not a valid choice, allowed values are: 1")
+	enum.Def[Level](2)
+	check("9 is not a valid choice, allowed values are: 1, 2")
+	enum.DefNamed[Level](2, "high")
+	check("9 is not a valid choice, allowed values are: 1, \"high\" (2)")
+	enum.Undef[Level](1)
+	check("9 is not a valid choice, allowed values are: \"high\" (2)")
+	enum.SetFormatter(func(l Level) string { return fmt.Sprintf("L%d", l) })
+	check("L9 is not a valid choice, allowed values are: L2")
+	enum.Clear[Level]()
+	enum.Def[Level](3)
+	check("9 is not a valid choice, allowed values are: 3")
+}
+
+func TestValidateConcurrentDef(t *testing.T) {
+	type Code int
+	enum.Def[Code](0)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 1; i <= 100; i++ {
+			enum.Def(Code(i))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for range 100 {
+			_ = enum.Validate[Code](-1)
+		}
+	}()
+	wg.Wait()
+	enum.SetErrorLimit[Code](0)
+	err := enum.Validate[Code](-1)
+	if !strings.HasSuffix(err.Error(), ", 99, 100") {
+		t.Errorf("Validate(-1) = %v, want all 101 values listed", err)
+	}
+}
+
+func mustPanic(t *testing.T, f func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	f()
+}
+
 func BenchmarkIsValid(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		enum.IsValid(StatusDraft)
@@ -134,3 +473,70 @@ func BenchmarkValidate(b *testing.B) {
 		_ = enum.Validate(invalidStatus)
 	}
 }
+
+func BenchmarkValidateLarge(b *testing.B) {
+	type Country string
+	for i := range 50 {
+		enum.Def(Country(fmt.Sprintf("country-%02d", i)))
+	}
+	enum.SetErrorLimit[Country](0)
+	invalidCountry := Country("atlantis")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = enum.Validate(invalidCountry)
+	}
+}
+
+func ExampleValidateValue() {
+	fields := []any{StatusOpen, Access(3), reflect.ValueOf(Status("postponed")), "open", nil}
+	for _, f := range fields {
+		fmt.Println(enum.ValidateValue(f))
+	}
+	// Output:
+	// <nil>
+	// 3 is not a valid choice, allowed values are: 1, 2, 4
+	// "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// enum: string is not an enum with definitions: enum: no definitions
+	// enum: can't validate nil: enum: no definitions
+}
+
+func TestValidateValueCleared(t *testing.T) {
+	type Zone string
+	enum.Def[Zone]("eu")
+	if err := enum.ValidateValue(Zone("eu")); err != nil {
+		t.Errorf("ValidateValue(eu) = %v", err)
+	}
+	enum.Clear[Zone]()
+	if err := enum.ValidateValue(Zone("eu")); !errors.Is(err, enum.ErrNoDefinitions) {
+		t.Errorf("ValidateValue(eu) after Clear = %v, want ErrNoDefinitions", err)
+	}
+}
+
+func ExampleValidateOrDefault() {
+	fmt.Println(enum.ValidateOrDefault(Status("merged"), StatusDraft))
+	fmt.Println(enum.ValidateOrDefault(Status("postponed"), StatusDraft))
+	fmt.Println(enum.ValidateOrDefaultOK(Status("postponed"), StatusDraft))
+	// Output:
+	// merged
+	// draft
+	// draft false
+}
+
+func TestValidateOrDefaultUndefinedFallback(t *testing.T) {
+	mustPanic(t, func() { enum.ValidateOrDefault(StatusOpen, Status("postponed")) })
+	mustPanic(t, func() { enum.ValidateOrDefaultOK[Access](1, 3) })
+}
+
+func ExampleIsRegistered() {
+	type Mode string
+	fmt.Println(enum.IsRegistered[Mode]())
+	enum.Def[Mode]("auto")
+	enum.Undef[Mode]("auto")
+	fmt.Println(enum.IsRegistered[Mode](), enum.Count[Mode]())
+	enum.Clear[Mode]()
+	fmt.Println(enum.IsRegistered[Mode]())
+	// Output:
+	// false
+	// true 0
+	// false
+}
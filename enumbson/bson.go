@@ -0,0 +1,102 @@
+// Package enumbson provides validation of enums decoded with go.mongodb.org/mongo-driver/bson.
+// It is a separate module, so that the enum package stays dependency-free.
+package enumbson
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/0xcafe-io/enum"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// enumType mirrors the constraint of enum package.
+type enumType interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~string
+}
+
+// BSON wraps a value of enum T to validate it when decoding BSON,
+// it implements bson.ValueMarshaler and bson.ValueUnmarshaler.
+// String enums are encoded as BSON strings, integer enums as int32, or int64 if they don't fit.
+// Usage:
+//   type PullRequest struct {
+//     Status enumbson.BSON[Status] `bson:"status"`
+//   }
+type BSON[T enumType] struct {
+	Val T
+}
+
+// MarshalBSONValue encodes the bare value, as if it wasn't wrapped.
+func (b BSON[T]) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	rv := reflect.ValueOf(b.Val)
+	switch {
+	case rv.Kind() == reflect.String:
+		return bson.MarshalValue(rv.String())
+	case rv.CanInt():
+		return marshalInt(rv.Int())
+	default: // enumType permits only strings and integers
+		u := rv.Uint()
+		if u > math.MaxInt64 {
+			return 0, nil, fmt.Errorf("enumbson: %d overflows int64", u)
+		}
+		return marshalInt(int64(u))
+	}
+}
+
+func marshalInt(n int64) (bsontype.Type, []byte, error) {
+	if n >= math.MinInt32 && n <= math.MaxInt32 {
+		return bson.MarshalValue(int32(n))
+	}
+	return bson.MarshalValue(n)
+}
+
+// UnmarshalBSONValue decodes the value and validates it.
+// String enums accept BSON strings, integer enums accept int32 and int64.
+// The driver prefixes errors with the key of the offending field.
+// On failure, the wrapped value is left untouched.
+func (b *BSON[T]) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	raw := bson.RawValue{Type: t, Value: data}
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	switch {
+	case rv.Kind() == reflect.String && t == bsontype.String:
+		s, ok := raw.StringValueOK()
+		if !ok {
+			return fmt.Errorf("enumbson: malformed BSON string")
+		}
+		rv.SetString(s)
+	case rv.Kind() != reflect.String && (t == bsontype.Int32 || t == bsontype.Int64):
+		n, ok := raw.AsInt64OK()
+		if !ok {
+			return fmt.Errorf("enumbson: malformed BSON %s", t)
+		}
+		if !setInt(rv, n) {
+			return fmt.Errorf("enumbson: %d overflows %s", n, rv.Type())
+		}
+	default:
+		return fmt.Errorf("enumbson: can't decode BSON %s into %s", t, rv.Type())
+	}
+	if err := enum.Validate(v); err != nil {
+		return err
+	}
+	b.Val = v
+	return nil
+}
+
+// setInt sets integer rv to n, reports false if n overflows it.
+func setInt(rv reflect.Value, n int64) bool {
+	if rv.CanInt() {
+		if rv.OverflowInt(n) {
+			return false
+		}
+		rv.SetInt(n)
+		return true
+	}
+	if n < 0 || rv.OverflowUint(uint64(n)) {
+		return false
+	}
+	rv.SetUint(uint64(n))
+	return true
+}
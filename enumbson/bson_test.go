@@ -0,0 +1,58 @@
+package enumbson_test
+
+import (
+	"fmt"
+
+	"github.com/0xcafe-io/enum"
+	"github.com/0xcafe-io/enum/enumbson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type Status string
+
+var (
+	StatusDraft  = enum.Def[Status]("draft")
+	StatusOpen   = enum.Def[Status]("open")
+	StatusMerged = enum.Def[Status]("merged")
+	StatusClosed = enum.Def[Status]("closed")
+)
+
+type Access int
+
+var (
+	AccessRead    = enum.Def[Access](1)
+	AccessComment = enum.Def[Access](2)
+	AccessWrite   = enum.Def[Access](4)
+)
+
+func ExampleBSON() {
+	type PullRequest struct {
+		Status enumbson.BSON[Status] `bson:"status"`
+		Access enumbson.BSON[Access] `bson:"access"`
+	}
+
+	data, err := bson.Marshal(PullRequest{
+		Status: enumbson.BSON[Status]{Val: StatusMerged},
+		Access: enumbson.BSON[Access]{Val: AccessWrite},
+	})
+	fmt.Println(bson.Raw(data), err)
+
+	for _, doc := range []bson.M{
+		{"status": "open", "access": int64(2)},
+		{"status": "postponed"},
+		{"access": int32(3)},
+		{"access": "4"},
+	} {
+		data, _ := bson.Marshal(doc)
+		var pr PullRequest
+		err := bson.Unmarshal(data, &pr)
+		fmt.Println(pr.Status.Val, pr.Access.Val, err)
+	}
+
+	// Output:
+	// {"status": "merged","access": {"$numberInt":"4"}} <nil>
+	// open 2 <nil>
+	//  0 error decoding key status: "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	//  0 error decoding key access: 3 is not a valid choice, allowed values are: 1, 2, 4
+	//  0 error decoding key access: enumbson: can't decode BSON string into enumbson_test.Access
+}
@@ -0,0 +1,8 @@
+module github.com/0xcafe-io/enum/enumbson
+
+go 1.23.1
+
+require (
+	github.com/0xcafe-io/enum v0.1.0
+	go.mongodb.org/mongo-driver v1.17.6
+)
@@ -0,0 +1,84 @@
+// Package enumcbor provides validation of enums decoded with github.com/fxamacker/cbor/v2.
+// It is a separate module, so that the enum package stays dependency-free.
+package enumcbor
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/0xcafe-io/enum"
+	"github.com/fxamacker/cbor/v2"
+)
+
+// enumType mirrors the constraint of enum package.
+type enumType interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~string
+}
+
+// major types of CBOR data items, see RFC 8949 section 3.1.
+var majorTypes = [...]string{
+	"unsigned integer", "negative integer", "byte string", "text string", "array", "map", "tag", "simple value",
+}
+
+// CBOR wraps a value of enum T to validate it when decoding CBOR,
+// it implements cbor.Marshaler and cbor.Unmarshaler.
+// String enums are decoded from text strings and byte strings,
+// integer enums from unsigned and negative integers.
+// Usage:
+//   type Reading struct {
+//     Unit enumcbor.CBOR[Unit] `cbor:"unit"`
+//   }
+type CBOR[T enumType] struct {
+	Val T
+}
+
+// MarshalCBOR encodes the bare value, as if it wasn't wrapped.
+// String enums are always encoded as text strings.
+func (c CBOR[T]) MarshalCBOR() ([]byte, error) {
+	rv := reflect.ValueOf(c.Val)
+	switch {
+	case rv.Kind() == reflect.String:
+		return cbor.Marshal(rv.String())
+	case rv.CanInt():
+		return cbor.Marshal(rv.Int())
+	default: // enumType permits only strings and integers
+		return cbor.Marshal(rv.Uint())
+	}
+}
+
+// UnmarshalCBOR decodes the value and validates it.
+// On failure, the wrapped value is left untouched.
+func (c *CBOR[T]) UnmarshalCBOR(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("enumcbor: empty input")
+	}
+	major := data[0] >> 5
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	switch {
+	case rv.Kind() == reflect.String && major == 2:
+		var b []byte
+		if err := cbor.Unmarshal(data, &b); err != nil {
+			return err
+		}
+		rv.SetString(string(b))
+	case rv.Kind() == reflect.String && major == 3:
+		var s string
+		if err := cbor.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		rv.SetString(s)
+	case rv.Kind() != reflect.String && (major == 0 || major == 1):
+		// cbor reports values that overflow T, including negative values of unsigned T
+		if err := cbor.Unmarshal(data, rv.Addr().Interface()); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("enumcbor: can't decode CBOR %s into %s", majorTypes[major], rv.Type())
+	}
+	if err := enum.Validate(v); err != nil {
+		return err
+	}
+	c.Val = v
+	return nil
+}
@@ -0,0 +1,73 @@
+package enumcbor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+	"github.com/0xcafe-io/enum/enumcbor"
+	"github.com/fxamacker/cbor/v2"
+)
+
+type Unit string
+
+var (
+	UnitCelsius    = enum.Def[Unit]("C")
+	UnitFahrenheit = enum.Def[Unit]("F")
+)
+
+type Level int
+
+var (
+	LevelLow    = enum.Def[Level](-1)
+	LevelNormal = enum.Def[Level](0)
+	LevelHigh   = enum.Def[Level](1)
+)
+
+func ExampleCBOR() {
+	type Reading struct {
+		Unit  enumcbor.CBOR[Unit]  `cbor:"unit"`
+		Level enumcbor.CBOR[Level] `cbor:"level"`
+	}
+
+	data, err := cbor.Marshal(Reading{
+		Unit:  enumcbor.CBOR[Unit]{Val: UnitFahrenheit},
+		Level: enumcbor.CBOR[Level]{Val: LevelLow},
+	})
+	fmt.Printf("%x %v\n", data, err)
+
+	var r Reading
+	fmt.Println(cbor.Unmarshal(data, &r), r.Unit.Val, r.Level.Val)
+
+	for _, doc := range []map[string]any{
+		{"unit": []byte("C"), "level": uint8(1)},
+		{"unit": "K"},
+		{"level": -2},
+		{"level": "1"},
+	} {
+		data, _ := cbor.Marshal(doc)
+		var r Reading
+		err := cbor.Unmarshal(data, &r)
+		fmt.Println(r.Unit.Val, r.Level.Val, err)
+	}
+
+	// Output:
+	// a264756e69746146656c6576656c20 <nil>
+	// <nil> F -1
+	// C 1 <nil>
+	//  0 "K" is not a valid choice, allowed values are: "C", "F"
+	//  0 -2 is not a valid choice, allowed values are: -1, 0, 1
+	//  0 enumcbor: can't decode CBOR text string into enumcbor_test.Level
+}
+
+func TestCBOROverflow(t *testing.T) {
+	type Code uint8
+	enum.Def[Code](1)
+	for _, n := range []int{-1, 256} {
+		data, _ := cbor.Marshal(n)
+		var c enumcbor.CBOR[Code]
+		if err := cbor.Unmarshal(data, &c); err == nil {
+			t.Errorf("Unmarshal(%d) = %v, want error", n, c.Val)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/0xcafe-io/enum/enumcbor
+
+go 1.23.1
+
+require (
+	github.com/0xcafe-io/enum v0.1.0
+	github.com/fxamacker/cbor/v2 v2.9.0
+)
+
+require github.com/x448/float16 v0.8.4 // indirect
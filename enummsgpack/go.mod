@@ -0,0 +1,10 @@
+module github.com/0xcafe-io/enum/enummsgpack
+
+go 1.23.1
+
+require (
+	github.com/0xcafe-io/enum v0.1.0
+	github.com/vmihailenco/msgpack/v5 v5.4.1
+)
+
+require github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
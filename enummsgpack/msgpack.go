@@ -0,0 +1,121 @@
+// Package enummsgpack provides validation of enums decoded with github.com/vmihailenco/msgpack/v5.
+// It is a separate module, so that the enum package stays dependency-free.
+package enummsgpack
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/0xcafe-io/enum"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// enumType mirrors the constraint of enum package.
+type enumType interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~string
+}
+
+// MsgPack wraps a value of enum T to validate it when decoding MessagePack,
+// it implements msgpack.CustomEncoder and msgpack.CustomDecoder.
+// String enums are decoded from the str family, integer enums from the int family.
+// Usage:
+//   type PullRequest struct {
+//     Status enummsgpack.MsgPack[Status] `msgpack:"status"`
+//   }
+type MsgPack[T enumType] struct {
+	Val T
+}
+
+// EncodeMsgpack encodes the bare value, as if it wasn't wrapped.
+func (m MsgPack[T]) EncodeMsgpack(enc *msgpack.Encoder) error {
+	rv := reflect.ValueOf(m.Val)
+	switch {
+	case rv.Kind() == reflect.String:
+		return enc.EncodeString(rv.String())
+	case rv.CanInt():
+		return enc.EncodeInt(rv.Int())
+	default: // enumType permits only strings and integers
+		return enc.EncodeUint(rv.Uint())
+	}
+}
+
+// DecodeMsgpack decodes the value and validates it.
+// On failure, the wrapped value is left untouched.
+func (m *MsgPack[T]) DecodeMsgpack(dec *msgpack.Decoder) error {
+	c, err := dec.PeekCode()
+	if err != nil {
+		return err
+	}
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	switch {
+	case rv.Kind() == reflect.String && msgpcode.IsString(c):
+		s, err := dec.DecodeString()
+		if err != nil {
+			return err
+		}
+		rv.SetString(s)
+	case rv.Kind() != reflect.String && isInt(c):
+		n, err := dec.DecodeInterfaceLoose() // int64 for signed codes, uint64 for unsigned ones
+		if err != nil {
+			return err
+		}
+		if !setInt(rv, n) {
+			return fmt.Errorf("enummsgpack: %v overflows %s", n, rv.Type())
+		}
+	default:
+		return fmt.Errorf("enummsgpack: can't decode msgpack code %#x into %s", c, rv.Type())
+	}
+	if err := enum.Validate(v); err != nil {
+		return err
+	}
+	m.Val = v
+	return nil
+}
+
+// isInt reports whether c starts a value of the int family.
+func isInt(c byte) bool {
+	if msgpcode.IsFixedNum(c) {
+		return true
+	}
+	switch c {
+	case msgpcode.Int8, msgpcode.Int16, msgpcode.Int32, msgpcode.Int64,
+		msgpcode.Uint8, msgpcode.Uint16, msgpcode.Uint32, msgpcode.Uint64:
+		return true
+	}
+	return false
+}
+
+// setInt sets integer rv to n, which is either int64 or uint64, reports false if n overflows rv.
+func setInt(rv reflect.Value, n any) bool {
+	switch n := n.(type) {
+	case int64:
+		if rv.CanInt() {
+			if rv.OverflowInt(n) {
+				return false
+			}
+			rv.SetInt(n)
+			return true
+		}
+		if n < 0 || rv.OverflowUint(uint64(n)) {
+			return false
+		}
+		rv.SetUint(uint64(n))
+		return true
+	case uint64:
+		if rv.CanUint() {
+			if rv.OverflowUint(n) {
+				return false
+			}
+			rv.SetUint(n)
+			return true
+		}
+		if n > 1<<63-1 || rv.OverflowInt(int64(n)) {
+			return false
+		}
+		rv.SetInt(int64(n))
+		return true
+	}
+	return false
+}
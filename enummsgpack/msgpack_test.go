@@ -0,0 +1,58 @@
+package enummsgpack_test
+
+import (
+	"fmt"
+
+	"github.com/0xcafe-io/enum"
+	"github.com/0xcafe-io/enum/enummsgpack"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type Status string
+
+var (
+	StatusDraft  = enum.Def[Status]("draft")
+	StatusOpen   = enum.Def[Status]("open")
+	StatusMerged = enum.Def[Status]("merged")
+	StatusClosed = enum.Def[Status]("closed")
+)
+
+type Access int
+
+var (
+	AccessRead    = enum.Def[Access](1)
+	AccessComment = enum.Def[Access](2)
+	AccessWrite   = enum.Def[Access](4)
+)
+
+func ExampleMsgPack() {
+	type PullRequest struct {
+		Status enummsgpack.MsgPack[Status] `msgpack:"status"`
+		Access enummsgpack.MsgPack[Access] `msgpack:"access"`
+	}
+
+	data, err := msgpack.Marshal(PullRequest{
+		Status: enummsgpack.MsgPack[Status]{Val: StatusMerged},
+		Access: enummsgpack.MsgPack[Access]{Val: AccessWrite},
+	})
+	fmt.Printf("%q %v\n", data, err)
+
+	for _, doc := range []map[string]any{
+		{"status": "open", "access": uint16(2)},
+		{"status": "postponed"},
+		{"access": -3},
+		{"access": "4"},
+	} {
+		data, _ := msgpack.Marshal(doc)
+		var pr PullRequest
+		err := msgpack.Unmarshal(data, &pr)
+		fmt.Println(pr.Status.Val, pr.Access.Val, err)
+	}
+
+	// Output:
+	// "\x82\xa6status\xa6merged\xa6access\x04" <nil>
+	// open 2 <nil>
+	//  0 "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	//  0 -3 is not a valid choice, allowed values are: 1, 2, 4
+	//  0 enummsgpack: can't decode msgpack code 0xa1 into enummsgpack_test.Access
+}
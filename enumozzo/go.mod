@@ -0,0 +1,10 @@
+module github.com/0xcafe-io/enum/enumozzo
+
+go 1.23.1
+
+require (
+	github.com/0xcafe-io/enum v0.1.0
+	github.com/go-ozzo/ozzo-validation/v4 v4.3.0
+)
+
+require github.com/asaskevich/govalidator v0.0.0-20200108200545-475eaeb16496 // indirect
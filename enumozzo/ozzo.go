@@ -0,0 +1,60 @@
+// Package enumozzo provides rules of github.com/go-ozzo/ozzo-validation for enums.
+// It is a separate module, so that the enum package stays dependency-free.
+package enumozzo
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/0xcafe-io/enum"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// enumType mirrors the constraint of enum package.
+type enumType interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~string
+}
+
+// Rule returns a rule that checks whether the value, of type T or *T, is defined for enum T.
+// Errors are the same as of enum.Validate. Following ozzo conventions, zero value and nil are skipped,
+// combine the rule with validation.Required or use RequiredRule to reject them.
+// Usage:
+//   validation.ValidateStruct(&req,
+//     validation.Field(&req.Status, enumozzo.Rule[Status]()),
+//   )
+func Rule[T enumType]() validation.Rule {
+	return rule[T]{}
+}
+
+// RequiredRule is like Rule but rejects zero value and nil with validation.ErrRequired,
+// unless zero value is defined for enum T.
+func RequiredRule[T enumType]() validation.Rule {
+	return rule[T]{required: true}
+}
+
+type rule[T enumType] struct {
+	required bool
+}
+
+// Validate implements validation.Rule.
+func (r rule[T]) Validate(value any) error {
+	value, isNil := validation.Indirect(value)
+	if isNil {
+		if r.required {
+			return validation.ErrRequired
+		}
+		return nil
+	}
+	v, ok := value.(T)
+	if !ok {
+		return validation.NewInternalError(fmt.Errorf("enumozzo: got %T, want %s", value, reflect.TypeFor[T]()))
+	}
+	var zero T
+	if v == zero && !enum.IsValid(v) {
+		if r.required {
+			return validation.ErrRequired
+		}
+		return nil
+	}
+	return enum.Validate(v)
+}
@@ -0,0 +1,70 @@
+package enumozzo_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+	"github.com/0xcafe-io/enum/enumozzo"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type Status string
+
+var (
+	StatusOpen   = enum.Def[Status]("open")
+	StatusClosed = enum.Def[Status]("closed")
+)
+
+type Priority int
+
+var (
+	PriorityLow  = enum.Def[Priority](1)
+	PriorityHigh = enum.Def[Priority](2)
+)
+
+func ExampleRule() {
+	type Request struct {
+		Status   Status
+		Previous *Status
+		Priority Priority
+	}
+	validate := func(req *Request) error {
+		return validation.ValidateStruct(req,
+			validation.Field(&req.Status, enumozzo.Rule[Status]()),
+			validation.Field(&req.Previous, enumozzo.Rule[Status]()),
+			validation.Field(&req.Priority, enumozzo.RequiredRule[Priority]()),
+		)
+	}
+
+	closed := StatusClosed
+	fmt.Println(validate(&Request{Status: StatusOpen, Previous: &closed, Priority: PriorityLow}))
+	fmt.Println(validate(&Request{Status: "wip", Priority: 3}))
+	fmt.Println(validate(&Request{}))
+
+	// Output:
+	// <nil>
+	// Priority: 3 is not a valid choice, allowed values are: 1, 2; Status: "wip" is not a valid choice, allowed values are: "open", "closed".
+	// Priority: cannot be blank.
+}
+
+func TestRuleWrongType(t *testing.T) {
+	err := enumozzo.Rule[Status]().Validate(1)
+	if _, ok := err.(validation.InternalError); !ok {
+		t.Errorf("got %v, want InternalError", err)
+	}
+}
+
+func TestRuleDefinedZero(t *testing.T) {
+	type Level int
+	enum.DefAll[Level](0, 1)
+	for _, v := range []any{Level(0), Level(1)} {
+		if err := enumozzo.RequiredRule[Level]().Validate(v); err != nil {
+			t.Errorf("RequiredRule().Validate(%v) = %v", v, err)
+		}
+	}
+	err := enumozzo.RequiredRule[Level]().Validate((*Level)(nil))
+	if ve, ok := err.(validation.Error); !ok || ve.Code() != validation.ErrRequired.Code() {
+		t.Errorf("RequiredRule().Validate(nil) = %v, want ErrRequired", err)
+	}
+}
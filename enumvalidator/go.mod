@@ -0,0 +1,19 @@
+module github.com/0xcafe-io/enum/enumvalidator
+
+go 1.23.1
+
+require (
+	github.com/0xcafe-io/enum v0.1.0
+	github.com/go-playground/validator/v10 v10.26.0
+)
+
+require (
+	github.com/gabriel-vasile/mimetype v1.4.8 // indirect
+	github.com/go-playground/locales v0.14.1 // indirect
+	github.com/go-playground/universal-translator v0.18.1 // indirect
+	github.com/leodido/go-urn v1.4.0 // indirect
+	golang.org/x/crypto v0.33.0 // indirect
+	golang.org/x/net v0.34.0 // indirect
+	golang.org/x/sys v0.30.0 // indirect
+	golang.org/x/text v0.22.0 // indirect
+)
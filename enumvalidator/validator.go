@@ -0,0 +1,32 @@
+// Package enumvalidator provides the "enum" tag of github.com/go-playground/validator for enums.
+// It is a separate module, so that the enum package stays dependency-free.
+package enumvalidator
+
+import (
+	"github.com/0xcafe-io/enum"
+	"github.com/go-playground/validator/v10"
+)
+
+// Tag is the tag registered by RegisterValidation.
+const Tag = "enum"
+
+// RegisterValidation registers Tag on v, it checks whether a field is defined for its enum type, see enum.ValidateValue.
+// Fields of types without definitions fail the check. Pointers are dereferenced by v, combine the tag with omitempty
+// to skip nil pointers and zero values.
+// Usage:
+//   validate := validator.New()
+//   enumvalidator.RegisterValidation(validate)
+//   type Request struct {
+//     Status Status `validate:"required,enum"`
+//   }
+func RegisterValidation(v *validator.Validate) error {
+	return v.RegisterValidation(Tag, isDefined)
+}
+
+func isDefined(fl validator.FieldLevel) bool {
+	f := fl.Field()
+	if !f.CanInterface() {
+		return false
+	}
+	return enum.ValidateValue(f) == nil
+}
@@ -0,0 +1,71 @@
+package enumvalidator_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+	"github.com/0xcafe-io/enum/enumvalidator"
+	"github.com/go-playground/validator/v10"
+)
+
+type Status string
+
+var (
+	StatusOpen   = enum.Def[Status]("open")
+	StatusClosed = enum.Def[Status]("closed")
+)
+
+type Priority int
+
+var (
+	PriorityLow  = enum.Def[Priority](1)
+	PriorityHigh = enum.Def[Priority](2)
+)
+
+func ExampleRegisterValidation() {
+	validate := validator.New()
+	if err := enumvalidator.RegisterValidation(validate); err != nil {
+		panic(err)
+	}
+
+	type Request struct {
+		Status   Status   `validate:"enum"`
+		Previous *Status  `validate:"omitempty,enum"`
+		Priority Priority `validate:"required,enum"`
+		Labels   []Status `validate:"dive,enum"`
+	}
+	closed := StatusClosed
+	fmt.Println(validate.Struct(Request{Status: StatusOpen, Previous: &closed, Priority: PriorityHigh}))
+
+	wip := Status("wip")
+	err := validate.Struct(Request{Status: "draft", Previous: &wip, Priority: 3, Labels: []Status{StatusOpen, ""}})
+	var errs validator.ValidationErrors
+	if errors.As(err, &errs) {
+		for _, fe := range errs {
+			fmt.Println(fe.Namespace(), fe.Tag(), fe.Value())
+		}
+	}
+
+	// Output:
+	// <nil>
+	// Request.Status enum draft
+	// Request.Previous enum wip
+	// Request.Priority enum 3
+	// Request.Labels[1] enum
+}
+
+func TestUnregisteredType(t *testing.T) {
+	validate := validator.New()
+	if err := enumvalidator.RegisterValidation(validate); err != nil {
+		t.Fatal(err)
+	}
+	type Unknown string
+	if err := validate.Var(Unknown("x"), "enum"); err == nil {
+		t.Error("got nil, want error for type without definitions")
+	}
+	if err := validate.Var(StatusOpen, "enum"); err != nil {
+		t.Errorf("got %v, want nil", err)
+	}
+}
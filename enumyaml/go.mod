@@ -0,0 +1,8 @@
+module github.com/0xcafe-io/enum/enumyaml
+
+go 1.23.1
+
+require (
+	github.com/0xcafe-io/enum v0.1.0
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,55 @@
+// Package enumyaml provides validation of enums decoded with gopkg.in/yaml.v3.
+// It is a separate module, so that the enum package stays dependency-free.
+package enumyaml
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/0xcafe-io/enum"
+	"gopkg.in/yaml.v3"
+)
+
+// enumType mirrors the constraint of enum package.
+type enumType interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~string
+}
+
+// YAML wraps a value of enum T to validate it when decoding YAML,
+// it implements yaml.Marshaler and yaml.Unmarshaler.
+// String enums are decoded from YAML strings, integer enums from YAML integers.
+// Usage:
+//   type Config struct {
+//     Status enumyaml.YAML[Status] `yaml:"status"`
+//   }
+type YAML[T enumType] struct {
+	Val T
+}
+
+// MarshalYAML encodes the bare value, as if it wasn't wrapped.
+func (y YAML[T]) MarshalYAML() (any, error) {
+	return y.Val, nil
+}
+
+// UnmarshalYAML decodes the value and validates it.
+// Errors are prefixed with the line of the node, the same way yaml.v3 does.
+// On failure, the wrapped value is left untouched.
+func (y *YAML[T]) UnmarshalYAML(node *yaml.Node) error {
+	typ := reflect.TypeFor[T]()
+	want := "!!int"
+	if typ.Kind() == reflect.String {
+		want = "!!str"
+	}
+	if node.Kind != yaml.ScalarNode || node.ShortTag() != want {
+		return fmt.Errorf("line %d: cannot unmarshal %s into %s", node.Line, node.ShortTag(), typ)
+	}
+	var v T
+	if err := node.Decode(&v); err != nil {
+		return err
+	}
+	if err := enum.Validate(v); err != nil {
+		return fmt.Errorf("line %d: %w", node.Line, err)
+	}
+	y.Val = v
+	return nil
+}
@@ -0,0 +1,62 @@
+package enumyaml_test
+
+import (
+	"fmt"
+
+	"github.com/0xcafe-io/enum"
+	"github.com/0xcafe-io/enum/enumyaml"
+	"gopkg.in/yaml.v3"
+)
+
+type Status string
+
+var (
+	StatusDraft  = enum.Def[Status]("draft")
+	StatusOpen   = enum.Def[Status]("open")
+	StatusMerged = enum.Def[Status]("merged")
+	StatusClosed = enum.Def[Status]("closed")
+)
+
+type Access int
+
+var (
+	AccessRead    = enum.Def[Access](1)
+	AccessComment = enum.Def[Access](2)
+	AccessWrite   = enum.Def[Access](4)
+)
+
+func ExampleYAML() {
+	type Config struct {
+		Status enumyaml.YAML[Status] `yaml:"status"`
+		Access enumyaml.YAML[Access] `yaml:"access"`
+	}
+
+	for _, input := range []string{
+		"status: open\naccess: 4",
+		"status: open\naccess: 3",
+		"status: postponed",
+		"status: [open]",
+		"access: \"4\"",
+		"status: 1",
+	} {
+		var c Config
+		err := yaml.Unmarshal([]byte(input), &c)
+		fmt.Println(c.Status.Val, c.Access.Val, err)
+	}
+
+	out, _ := yaml.Marshal(Config{
+		Status: enumyaml.YAML[Status]{Val: StatusMerged},
+		Access: enumyaml.YAML[Access]{Val: AccessWrite},
+	})
+	fmt.Print(string(out))
+
+	// Output:
+	// open 4 <nil>
+	// open 0 line 2: 3 is not a valid choice, allowed values are: 1, 2, 4
+	//  0 line 1: "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	//  0 line 1: cannot unmarshal !!seq into enumyaml_test.Status
+	//  0 line 1: cannot unmarshal !!str into enumyaml_test.Access
+	//  0 line 1: cannot unmarshal !!int into enumyaml_test.Status
+	// status: merged
+	// access: 4
+}
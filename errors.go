@@ -0,0 +1,412 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var (
+	// ErrInvalidValue is wrapped by errors about values which are not defined for their enum.
+	ErrInvalidValue = errors.New("enum: invalid value")
+	// ErrNotDefined is the same error as ErrInvalidValue, either name can be used with errors.Is.
+	ErrNotDefined = ErrInvalidValue
+	// ErrNoDefinitions is wrapped by errors about values of enums which don't have any definition.
+	ErrNoDefinitions = errors.New("enum: no definitions")
+	// ErrDeprecated is wrapped by errors about deprecated values, see ValidateStrict.
+	ErrDeprecated = errors.New("enum: deprecated value")
+	// ErrRequired is wrapped by errors about missing values, see ValidateRequired.
+	ErrRequired = errors.New("enum: value is required")
+	// ErrMalformed is wrapped by errors about binary input that can't be decoded, see DecodeBinary.
+	ErrMalformed = errors.New("enum: malformed input")
+	// ErrMissingKey is wrapped by errors about absent query parameters, see FromQuery.
+	ErrMissingKey = errors.New("enum: missing key")
+	// ErrTypeMismatch is wrapped by errors about values that can't be converted to the enum type, see Coerce.
+	ErrTypeMismatch = errors.New("enum: type mismatch")
+	// ErrEmptyValue is wrapped by errors about empty fields, see ValidateCSVColumn, and disallowed zero values, see DisallowZero.
+	ErrEmptyValue = errors.New("enum: empty value")
+)
+
+// ValidationError describes a value that is not defined for its enum.
+// It wraps either ErrInvalidValue or ErrNoDefinitions, so errors.Is can be used to tell them apart.
+// Errors about zero values of enums given in DisallowZero wrap ErrEmptyValue as well.
+// Use errors.As to inspect it, or AsValidationError to get typed value and allowed values:
+//   var ve *enum.ValidationError
+//   if errors.As(err, &ve) {
+//     fmt.Println(ve.TypeName(), ve.Value(), ve.Allowed())
+//   }
+type ValidationError struct {
+	typ     typeID
+	value   any    // always enumType
+	invalid string // value as it is shown in the message
+	allowed any    // always []enumType, nil if the enum doesn't have any definition
+	msg     string
+	reason  error
+
+	// The suggestion is computed on first use, since it is much more expensive than the rest of the error.
+	hintOnce   sync.Once
+	hintOf     func(e *ValidationError) (hint any, formatted string) // nil if the value can't have a suggestion
+	hint       any                                                   // always enumType, nil if there is no suggestion
+	appendHint bool                                                  // whether the suggestion is mentioned in msg
+}
+
+// Error returns human-readable description, listing allowed values.
+// It takes read lock of definitions, so it must not be called while mu is held.
+func (e *ValidationError) Error() string {
+	e.resolveHint()
+	return e.msg
+}
+
+// resolveHint computes the suggestion once, appending it to the message if needed.
+func (e *ValidationError) resolveHint() {
+	e.hintOnce.Do(func() {
+		if e.hintOf == nil {
+			return
+		}
+		h, formatted := e.hintOf(e)
+		if h == nil {
+			return
+		}
+		e.hint = h
+		if e.appendHint {
+			e.msg += " (did you mean " + formatted + "?)"
+		}
+	})
+}
+
+// Unwrap returns ErrInvalidValue or ErrNoDefinitions, or an error wrapping ErrInvalidValue and ErrEmptyValue.
+func (e *ValidationError) Unwrap() error {
+	return e.reason
+}
+
+// TypeName returns the name of the enum type.
+func (e *ValidationError) TypeName() string {
+	return e.typ.Name()
+}
+
+// Value returns the invalid value.
+// For errors of Parse, it is the zero value if input couldn't be converted to the enum type.
+func (e *ValidationError) Value() any {
+	return e.value
+}
+
+// Allowed returns defined values of the enum at the moment of validation, in the same order as ValuesOf.
+// It is safe to modify the returned slice.
+func (e *ValidationError) Allowed() []any {
+	if e.allowed == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(e.allowed)
+	allowed := make([]any, rv.Len())
+	for i := range allowed {
+		allowed[i] = rv.Index(i).Interface()
+	}
+	return allowed
+}
+
+// Suggestion returns the defined value the invalid value is likely a misspelling of, see Validate.
+// For errors of Parse, it is the value whose value or name the input is likely a misspelling of.
+// Reports false if there is no such value.
+func (e *ValidationError) Suggestion() (any, bool) {
+	e.resolveHint()
+	return e.hint, e.hint != nil
+}
+
+// Formatted returns the invalid value and allowed values as they are shown in the default message,
+// e.g. for translating the message at render time, see Localize.
+func (e *ValidationError) Formatted() (invalid string, allowed []string) {
+	if e.allowed == nil {
+		return e.invalid, nil
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	if f, ok := allowedFormatters[e.typ]; ok {
+		allowed = f(e.allowed)
+	}
+	return e.invalid, allowed
+}
+
+// Localize returns the message in language lang, built by the printer given in SetMessagePrinter.
+// Returns Error if there is no printer, or the enum doesn't have any definition.
+func (e *ValidationError) Localize(lang string) string {
+	mu.RLock()
+	p := messagePrinter
+	mu.RUnlock()
+	if p == nil || e.allowed == nil {
+		return e.Error()
+	}
+	invalid, allowed := e.Formatted()
+	return p(lang, invalid, allowed)
+}
+
+// AsValidationError finds the first *ValidationError in err's tree, like errors.As does,
+// and returns its value and allowed values typed as enum T.
+// Reports false if there is no such error, or it is about a different enum.
+// Allowed values are safe to modify.
+func AsValidationError[T enumType](err error) (value T, allowed []T, ok bool) {
+	var ve *ValidationError
+	if !errors.As(err, &ve) || ve.typ != idOf[T]() {
+		return value, nil, false
+	}
+	allowed, _ = ve.allowed.([]T)
+	return ve.value.(T), slices.Clone(allowed), true
+}
+
+// invalidErr is like validationErr but tells that v is empty if it is a disallowed zero value,
+// and suggests the value v is a misspelling of if there is one, mu must be held.
+// The suggestion is computed by Error of the result, which takes the lock, so it must be called after mu is released.
+func invalidErr[T enumType](typ typeID, v T) error {
+	err := validationErr(typ, v, formatValue(typ, v))
+	e := err.(*ValidationError)
+	if e.allowed == nil {
+		return err
+	}
+	if isDisallowedZero(typ, v) {
+		e.msg = fmt.Sprintf("%s value is empty", typ.Name())
+		e.reason = errZero
+		return err
+	}
+	if f, ok := hinters[typ]; ok {
+		e.setHintOf(f)
+	}
+	return err
+}
+
+// setHintOf makes e suggest the value computed by hintOf, appended to the message unless it is custom.
+// mu must be held.
+func (e *ValidationError) setHintOf(hintOf func(e *ValidationError) (any, string)) {
+	_, custom := errorFuncs[e.typ]
+	e.hintOf = hintOf
+	e.appendHint = !custom
+}
+
+// values are hintOf of each string enum with definitions, they are looked up rather than instantiated
+// when creating errors, since instantiating a generic function as a value allocates.
+var hinters = map[typeID]func(e *ValidationError) (any, string){}
+
+// hintOf returns the suggestion for invalid value of e, and the suggestion as it is shown in messages.
+func hintOf[T enumType](e *ValidationError) (any, string) {
+	mu.RLock()
+	defer mu.RUnlock()
+	h, ok := hint(e.typ, e.value.(T), e.allowed.([]T))
+	if !ok {
+		return nil, ""
+	}
+	return h, formatValue(e.typ, h)
+}
+
+// Unwrap returns all *ValidationError in err's tree, in the order errors.As would find them,
+// e.g. for rendering errors of batch validators and ValidateStruct as a list. They are joined via errors.Join,
+// with positions or field paths wrapping each *ValidationError, so that errors.Is and errors.As work as well.
+// Returns nil if there are none.
+// Usage:
+//   for _, ve := range enum.Unwrap(enum.ValidateStruct(req)) {
+//     fields = append(fields, FieldError{Type: ve.TypeName(), Value: ve.Value()})
+//   }
+func Unwrap(err error) []*ValidationError {
+	var found []*ValidationError
+	var walk func(error)
+	walk = func(err error) {
+		switch e := err.(type) {
+		case nil:
+		case *ValidationError:
+			found = append(found, e)
+		case interface{ Unwrap() error }:
+			walk(e.Unwrap())
+		case interface{ Unwrap() []error }:
+			for _, err := range e.Unwrap() {
+				walk(err)
+			}
+		}
+	}
+	walk(err)
+	return found
+}
+
+// validationErr returns an error for invalid value v of enum typ, formatted as invalid in the message.
+// mu must be held.
+func validationErr[T enumType](typ typeID, v T, invalid string) error {
+	e := &ValidationError{typ: typ, value: v, invalid: invalid, reason: ErrInvalidValue}
+	group, enumExists := groups[typ]
+	if !enumExists {
+		e.msg = fmt.Sprintf("%s doesn't have any definition", typ.Name())
+		e.reason = ErrNoDefinitions
+		return e
+	}
+	vals := withoutZero(typ, group.([]T))
+	e.allowed = vals
+	if f, ok := errorFuncs[typ]; ok {
+		e.msg = f.(func(T, []T) string)(v, slices.Clone(vals))
+		return e
+	}
+	e.msg = errMsg(typ, invalid, vals)
+	return e
+}
+
+// values are always func(enumType, []enumType) string given in SetErrorFunc.
+var errorFuncs = map[typeID]any{}
+
+// SetErrorFunc sets f to build messages of errors about values not defined for enum T,
+// returned by Validate, Parse, decoding wrappers and batch validators, instead of the default message.
+// f receives the invalid value and all defined values, it is safe to modify the latter.
+// For errors of Parse, invalid is the zero value if input couldn't be converted to T.
+// Errors about enums without definitions are not affected. Nil f restores the default.
+// f is called while definitions are locked, so it must not call functions of the package.
+// SetErrorFunc is meant to be called once, during initialization.
+// Usage:
+//   enum.SetErrorFunc(func(invalid Status, allowed []Status) string {
+//     return fmt.Sprintf("status must be one of: %v", allowed)
+//   })
+func SetErrorFunc[T enumType](f func(invalid T, allowed []T) string) {
+	typ := idOf[T]()
+	mu.Lock()
+	defer mu.Unlock()
+	if f == nil {
+		delete(errorFuncs, typ)
+		return
+	}
+	errorFuncs[typ] = f
+}
+
+// defaultErrorLimit is the number of allowed values listed in error messages, unless changed via SetErrorLimit.
+const defaultErrorLimit = 10
+
+// values are limits given in SetErrorLimit.
+var errorLimits = map[typeID]int{}
+
+// SetErrorLimit sets the number of allowed values of enum T listed in error messages, 10 by default.
+// The rest is summarized, e.g. "… and 390 more", all values are still returned by ValidationError.Allowed.
+// Non-positive n lifts the limit. SetErrorLimit is meant to be called once, during initialization.
+func SetErrorLimit[T enumType](n int) {
+	typ := idOf[T]()
+	mu.Lock()
+	defer mu.Unlock()
+	changed(typ)
+	errorLimits[typ] = n
+}
+
+// errMsg lists vals of enum typ as allowed choices for already formatted invalid value, mu must be held.
+func errMsg[T enumType](typ typeID, invalid string, vals []T) string {
+	tail, ok := tails.Load(typ)
+	if !ok {
+		limit, ok := errorLimits[typ]
+		if !ok {
+			limit = defaultErrorLimit
+		}
+		if _, ok := sortedErrors[typ]; ok {
+			vals = withoutZero(typ, sorted[T](typ))
+		}
+		tail = " is not a valid choice, allowed values are: " + formatList(typ, vals, limit)
+		tails.Store(typ, tail)
+	}
+	return invalid + tail.(string)
+}
+
+// formatList lists vals of enum typ separated by commas, the rest is summarized if there are more than limit of them.
+// Non-positive limit lists all of them, mu must be held.
+func formatList[T enumType](typ typeID, vals []T, limit int) string {
+	if len(vals) == 0 {
+		return ""
+	}
+	listed := vals
+	if limit > 0 && len(vals) > limit {
+		listed = vals[:limit]
+	}
+	sb := strings.Builder{}
+	sb.WriteString(formatValue(typ, listed[0]))
+	for _, v := range listed[1:] {
+		sb.WriteString(", ")
+		sb.WriteString(formatValue(typ, v))
+	}
+	if len(listed) < len(vals) {
+		fmt.Fprintf(&sb, ", … and %d more", len(vals)-len(listed))
+	}
+	return sb.String()
+}
+
+// AllowedValues returns defined values of enum T separated by commas, formatted and ordered the same way as in error messages,
+// e.g. for documentation or messages of other layers. Unlike error messages, all values are listed regardless of SetErrorLimit.
+// Returns an empty string if enum T doesn't have any definition.
+// Usage:
+//   flag.StringVar(&status, "status", "draft", "one of: "+enum.AllowedValues[Status]())
+func AllowedValues[T enumType]() string {
+	typ := idOf[T]()
+	mu.RLock()
+	defer mu.RUnlock()
+	vals, _ := groups[typ].([]T)
+	if _, ok := sortedErrors[typ]; ok {
+		vals = sorted[T](typ)
+	}
+	return formatList(typ, withoutZero(typ, vals), 0)
+}
+
+// keys are types given in SortErrors.
+var sortedErrors = map[typeID]struct{}{}
+
+// SortErrors makes error messages of enum T list allowed values in ascending order,
+// lexically for strings and numerically for integers, instead of the order of definitions.
+// It applies to AllowedValues and ValidationError.Formatted as well, but not to ValuesOf and ValidationError.Allowed.
+// SortErrors is meant to be called once, during initialization.
+func SortErrors[T enumType]() {
+	typ := idOf[T]()
+	mu.Lock()
+	defer mu.Unlock()
+	changed(typ)
+	sortedErrors[typ] = struct{}{}
+}
+
+// messagePrinter is the printer given in SetMessagePrinter.
+var messagePrinter func(lang, invalid string, allowed []string) string
+
+// values are formatAll of each enum with definitions, for formatting allowed values of ValidationError.
+var allowedFormatters = map[typeID]func(vals any) []string{}
+
+// formatAll formats vals, which are []T, as they are shown in messages, mu must be held.
+func formatAll[T enumType](vals any) []string {
+	typ := idOf[T]()
+	listed := vals.([]T)
+	if _, ok := sortedErrors[typ]; ok {
+		listed = slices.Sorted(slices.Values(listed))
+	}
+	formatted := make([]string, 0, len(listed))
+	for _, v := range listed {
+		formatted = append(formatted, formatValue(typ, v))
+	}
+	return formatted
+}
+
+// SetMessagePrinter sets p to build messages of validation errors in other languages, see ValidateLang.
+// p receives the language tag, the invalid value and allowed values as they are shown in the default message.
+// Nil p restores the default English message. SetMessagePrinter is meant to be called once, during initialization.
+// Usage:
+//   enum.SetMessagePrinter(func(lang, invalid string, allowed []string) string {
+//     if lang == "de" {
+//       return invalid + " ist keine gültige Auswahl, erlaubte Werte sind: " + strings.Join(allowed, ", ")
+//     }
+//     return invalid + " is not a valid choice, allowed values are: " + strings.Join(allowed, ", ")
+//   })
+func SetMessagePrinter(p func(lang, invalid string, allowed []string) string) {
+	mu.Lock()
+	defer mu.Unlock()
+	messagePrinter = p
+}
+
+// ValidateLang is like Validate but the message of the error is in language lang, see SetMessagePrinter.
+func ValidateLang[T enumType](lang string, v T) error {
+	err := Validate(v)
+	if err == nil {
+		return nil
+	}
+	e := err.(*ValidationError)
+	mu.RLock()
+	p := messagePrinter
+	mu.RUnlock()
+	if p != nil {
+		e.msg = e.Localize(lang)
+		e.appendHint = false // the suggestion is in English
+	}
+	return e
+}
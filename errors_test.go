@@ -0,0 +1,182 @@
+package enum_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleValidationError() {
+	err := enum.Validate(Status("postponed"))
+
+	var ve *enum.ValidationError
+	if errors.As(err, &ve) {
+		fmt.Printf("%s %q %q\n", ve.TypeName(), ve.Value(), ve.Allowed())
+	}
+
+	_, err = enum.Parse[Access]("mergd")
+	if errors.As(err, new(*enum.ValidationError)) {
+		fmt.Println("Parse error is a ValidationError too")
+	}
+
+	// Output:
+	// Status "postponed" ["draft" "open" "merged" "closed"]
+	// Parse error is a ValidationError too
+}
+
+func ExampleAsValidationError() {
+	err := fmt.Errorf("access: %w", enum.Validate[Access](3))
+	value, allowed, ok := enum.AsValidationError[Access](err)
+	fmt.Println(value, allowed, ok)
+
+	_, _, ok = enum.AsValidationError[Status](err)
+	fmt.Println(ok)
+
+	type Nothing int
+	value2, allowed2, ok := enum.AsValidationError[Nothing](enum.Validate[Nothing](1))
+	fmt.Println(value2, allowed2, ok)
+
+	// Output:
+	// 3 [1 2 4] true
+	// false
+	// 1 [] true
+}
+
+func ExampleErrInvalidValue() {
+	type Nothing int
+	for _, err := range []error{
+		enum.Validate(Status("postponed")),
+		enum.Validate[Nothing](1),
+	} {
+		switch {
+		case errors.Is(err, enum.ErrInvalidValue):
+			fmt.Println("invalid value:", err)
+		case errors.Is(err, enum.ErrNoDefinitions):
+			fmt.Println("no definitions:", err)
+		}
+	}
+
+	// Output:
+	// invalid value: "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// no definitions: Nothing doesn't have any definition
+}
+
+func TestErrNotDefined(t *testing.T) {
+	type Nothing int
+	if err := enum.Validate(Status("postponed")); !errors.Is(err, enum.ErrNotDefined) || errors.Is(err, enum.ErrNoDefinitions) {
+		t.Errorf("Validate(postponed) = %v, want ErrNotDefined", err)
+	}
+	if err := enum.Validate[Nothing](1); errors.Is(err, enum.ErrNotDefined) || !errors.Is(err, enum.ErrNoDefinitions) {
+		t.Errorf("Validate[Nothing](1) = %v, want ErrNoDefinitions", err)
+	}
+}
+
+func ExampleSetErrorFunc() {
+	type Stage string
+	enum.DefAll[Stage]("draft", "review", "done")
+	enum.SetErrorFunc(func(invalid Stage, allowed []Stage) string {
+		return fmt.Sprintf("stage must be one of: %v", allowed)
+	})
+
+	fmt.Println(enum.Validate[Stage]("archived"))
+	fmt.Println(enum.Parse[Stage]("reviw"))
+	fmt.Println(enum.ValidateAll[Stage]("draft", "todo"))
+	var v enum.Value[Stage]
+	fmt.Println(json.Unmarshal([]byte(`"wip"`), &v))
+
+	enum.Clear[Stage]()
+	enum.Def[Stage]("draft")
+	fmt.Println(enum.Validate[Stage]("archived"))
+
+	// Output:
+	// stage must be one of: [draft review done]
+	//  stage must be one of: [draft review done]
+	// index 1: stage must be one of: [draft review done]
+	// stage must be one of: [draft review done]
+	// "archived" is not a valid choice, allowed values are: "draft"
+}
+
+func ExampleValidateLang() {
+	defer enum.Snapshot()()
+	enum.SetMessagePrinter(func(lang, invalid string, allowed []string) string {
+		switch lang {
+		case "de":
+			return invalid + " ist keine gültige Auswahl, erlaubte Werte sind: " + strings.Join(allowed, ", ")
+		default:
+			return invalid + " is not a valid choice, allowed values are: " + strings.Join(allowed, ", ")
+		}
+	})
+
+	fmt.Println(enum.ValidateLang("de", Status("postponed")))
+	fmt.Println(enum.ValidateLang[Access]("en", 3))
+	fmt.Println(enum.ValidateLang("de", StatusOpen))
+
+	err := enum.Validate[Access](8)
+	var ve *enum.ValidationError
+	if errors.As(err, &ve) {
+		fmt.Println(ve.Formatted())
+		fmt.Println(ve.Localize("de"))
+	}
+
+	// Output:
+	// "postponed" ist keine gültige Auswahl, erlaubte Werte sind: "draft", "open", "merged", "closed"
+	// 3 is not a valid choice, allowed values are: 1, 2, 4
+	// <nil>
+	// 8 [1 2 4]
+	// 8 ist keine gültige Auswahl, erlaubte Werte sind: 1, 2, 4
+}
+
+func ExampleAllowedValues() {
+	type Nothing int
+	fmt.Println(enum.AllowedValues[Status]())
+	fmt.Println(enum.AllowedValues[Access]())
+	fmt.Printf("%q\n", enum.AllowedValues[Nothing]())
+	// Output:
+	// "draft", "open", "merged", "closed"
+	// 1, 2, 4
+	// ""
+}
+
+func ExampleSortErrors() {
+	type Region string
+	enum.DefAll[Region]("us-west", "eu-central", "ap-south")
+	enum.SortErrors[Region]()
+
+	err := enum.Validate[Region]("mars")
+	fmt.Println(err)
+	fmt.Println(enum.ValuesOf[Region]())
+
+	enum.Def[Region]("af-north")
+	fmt.Println(enum.AllowedValues[Region]())
+
+	// Output:
+	// "mars" is not a valid choice, allowed values are: "ap-south", "eu-central", "us-west"
+	// [us-west eu-central ap-south]
+	// "af-north", "ap-south", "eu-central", "us-west"
+}
+
+func ExampleUnwrap() {
+	type Filter struct {
+		Status Status
+		Access []Access
+	}
+	err := errors.Join(
+		enum.ValidateStruct(Filter{Status: "postponed", Access: []Access{1, 3}}),
+		fmt.Errorf("sort: %w", enum.ValidateMany[Status]("open", "newest")),
+		errors.New("page: must be positive"),
+	)
+	for _, ve := range enum.Unwrap(err) {
+		fmt.Println(ve.TypeName(), ve.Value())
+	}
+	fmt.Println(enum.Unwrap(errors.New("other")) == nil)
+
+	// Output:
+	// Status postponed
+	// Access 3
+	// Status newest
+	// true
+}
@@ -0,0 +1,38 @@
+package enum
+
+// Flag is a command-line flag holding a value of enum T, it implements flag.Value.
+// Usage:
+//   status := StatusOpen // default
+//   flag.Var(enum.NewFlag(&status), "status", "status of pull requests to show")
+type Flag[T enumType] struct {
+	p *T
+}
+
+// NewFlag returns a flag which stores the value in p.
+// The value p points to is used as the default.
+func NewFlag[T enumType](p *T) *Flag[T] {
+	return &Flag[T]{p: p}
+}
+
+// Get returns the current value of the flag.
+func (f *Flag[T]) Get() T {
+	return *f.p
+}
+
+// String returns the current value in the form accepted by Set.
+func (f *Flag[T]) String() string {
+	if f == nil || f.p == nil {
+		return "" // flag package calls String on zero value to detect defaults
+	}
+	return toString(*f.p)
+}
+
+// Set parses s and stores the value, see Parse.
+func (f *Flag[T]) Set(s string) error {
+	v, err := Parse[T](s)
+	if err != nil {
+		return err
+	}
+	*f.p = v
+	return nil
+}
@@ -0,0 +1,31 @@
+package enum_test
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleFlag() {
+	fs := flag.NewFlagSet("pulls", flag.ContinueOnError)
+	fs.SetOutput(os.Stdout)
+
+	status := StatusOpen
+	statusFlag := enum.NewFlag(&status)
+	fs.Var(statusFlag, "status", "status of pull requests to show")
+	fs.PrintDefaults()
+
+	fmt.Println(fs.Parse([]string{"-status", "merged"}), status, statusFlag.Get())
+	_ = fs.Parse([]string{"-status", "postponed"})
+
+	// Output:
+	//   -status value
+	//     	status of pull requests to show (default open)
+	// <nil> merged merged
+	// invalid value "postponed" for flag -status: "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// Usage of pulls:
+	//   -status value
+	//     	status of pull requests to show (default open)
+}
@@ -0,0 +1,46 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// values are always func(enumType) string given in SetFormatter.
+var formatters = map[typeID]any{}
+
+// SetFormatter sets f to format values of enum T in messages produced by the package, e.g. in Validate errors.
+// By default, strings are quoted and integers are shown in decimal form, with a name or a label if it is given.
+// Nil f restores the default. f is called while definitions are locked, so it must not call functions of the package.
+// SetFormatter is meant to be called once, during initialization.
+// Usage:
+//   enum.SetFormatter(func(a Access) string { return fmt.Sprintf("%#04b", a) })
+func SetFormatter[T enumType](f func(T) string) {
+	typ := idOf[T]()
+	mu.Lock()
+	defer mu.Unlock()
+	changed(typ)
+	if f == nil {
+		delete(formatters, typ)
+		return
+	}
+	formatters[typ] = f
+}
+
+// formatValue returns v as it is shown in messages, mu must be held.
+func formatValue[T enumType](typ typeID, v T) string {
+	if f, ok := formatters[typ]; ok {
+		return f.(func(T) string)(v)
+	}
+	// String method of T is ignored, it may call functions of the package and lock mu again.
+	if typ.Kind() == reflect.String {
+		return strconv.Quote(toString(v)) // use quotes for strings to visually distinguish them from integers
+	}
+	if name, ok := names[typeValue[T]{typ: typ, val: v}]; ok {
+		return fmt.Sprintf("%q (%s)", name, toString(v))
+	}
+	if label, ok := labels[typeValue[T]{typ: typ, val: v}]; ok {
+		return fmt.Sprintf("%q (%s)", label, toString(v))
+	}
+	return toString(v)
+}
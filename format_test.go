@@ -0,0 +1,46 @@
+package enum_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleSetFormatter() {
+	type Mode int
+	enum.DefAll[Mode](1, 2, 4)
+	enum.SetFormatter(func(m Mode) string { return fmt.Sprintf("%#04b", int(m)) })
+	fmt.Println(enum.Validate[Mode](3))
+
+	enum.SetFormatter[Mode](nil)
+	fmt.Println(enum.Validate[Mode](3))
+
+	// Output:
+	// 0b0011 is not a valid choice, allowed values are: 0b0001, 0b0010, 0b0100
+	// 3 is not a valid choice, allowed values are: 1, 2, 4
+}
+
+func TestDefDoesntFormat(t *testing.T) {
+	t.Cleanup(enum.Snapshot())
+	type Mode int
+	calls := 0
+	enum.SetFormatter(func(m Mode) string {
+		calls++
+		return fmt.Sprint(int(m))
+	})
+	enum.DefAll[Mode](1, 2)
+	enum.DefNamed[Mode](3, "third")
+	if _, err := enum.DefUnique[Mode](4); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := enum.TryDef[Mode](5); err != nil {
+		t.Fatal(err)
+	}
+	if err := enum.DefAlias[Mode](1, 10); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 0 {
+		t.Errorf("formatter is called %d times by definitions of a non-frozen enum", calls)
+	}
+}
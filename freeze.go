@@ -0,0 +1,89 @@
+package enum
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// keys are types sealed via Freeze.
+var frozen = map[typeID]struct{}{}
+
+// Freeze seals enum T, so that the set of its valid values can't change anymore.
+// After that, defining new values, names or aliases for T panics (DefAlias and DefAliasName return an error instead).
+// Redefining existing values is still allowed and ignored as usual.
+// Freeze is idempotent, it is meant to be called at the end of initialization.
+func Freeze[T enumType]() {
+	mu.Lock()
+	defer mu.Unlock()
+	frozen[idOf[T]()] = struct{}{}
+}
+
+// IsFrozen reports whether enum T is sealed via Freeze.
+func IsFrozen[T enumType]() bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	_, ok := frozen[idOf[T]()]
+	return ok
+}
+
+// Seal is the same as Freeze, subsequent definitions of new values of enum T panic,
+// or return an error if they are made via TryDef. Seal is undone by Clear.
+// Usage:
+//   func init() {
+//     enum.Seal[Status]()
+//   }
+func Seal[T enumType]() {
+	Freeze[T]()
+}
+
+// IsSealed is the same as IsFrozen, e.g. for asserting in tests that all enums are sealed after initialization.
+func IsSealed[T enumType]() bool {
+	return IsFrozen[T]()
+}
+
+// TryDef is like Def but returns an error instead of panicking if enum T is frozen, see Seal.
+// Redefining an existing value is not an error.
+func TryDef[T enumType](v T) (T, error) {
+	typID := idOf[T]()
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := defs[typeValue[T]{val: v, typ: typID}]; ok {
+		return v, nil
+	}
+	if err := checkFrozen(typID, func() string { return formatValue(typID, v) }); err != nil {
+		return v, err
+	}
+	def(typID, v)
+	return v, nil
+}
+
+// checkFrozen returns an error if enum typ is frozen, mu must be held.
+// what describes the definition, it is called only if the enum is frozen, since formatting values is not free.
+// The error mentions the position of the code that called the package, to find stray definitions.
+func checkFrozen(typ typeID, what func() string) error {
+	if _, ok := frozen[typ]; ok {
+		return fmt.Errorf("%s: can't define %s, enum is frozen (called at %s)", typ.Name(), what(), callerPos())
+	}
+	return nil
+}
+
+// pkgPrefix is the prefix of names of functions of the package, as reported by runtime.
+var pkgPrefix = reflect.TypeFor[typeName]().PkgPath() + "."
+
+// callerPos returns file:line of the innermost caller outside of the package.
+func callerPos() string {
+	var pcs [16]uintptr
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs[:])])
+	for {
+		f, more := frames.Next()
+		if !strings.HasPrefix(f.Function, pkgPrefix) {
+			return fmt.Sprintf("%s:%d", filepath.Base(f.File), f.Line)
+		}
+		if !more {
+			return "unknown position"
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package enum_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleFreeze() {
+	type Color string
+	enum.DefAll[Color]("red", "green")
+	enum.Freeze[Color]()
+	fmt.Println(enum.IsFrozen[Color](), enum.IsFrozen[Status]())
+
+	enum.Def[Color]("red") // redefinition is fine
+	defer func() {
+		msg, _, _ := strings.Cut(fmt.Sprint(recover()), " (called at") // position of the caller varies, see TestFrozenCallerPos
+		fmt.Println(msg)
+		fmt.Println(enum.ValuesOf[Color]())
+	}()
+	enum.Def[Color]("blue")
+
+	// Output:
+	// true false
+	// Color: can't define "blue", enum is frozen
+	// [red green]
+}
+
+func TestFreeze(t *testing.T) {
+	type Level int
+	enum.DefNamed[Level](1, "low")
+	enum.Freeze[Level]()
+	enum.Freeze[Level]() // idempotent
+
+	enum.DefNamed[Level](1, "low")
+	mustPanic(t, func() { enum.DefNamed[Level](1, "low", "minor") })
+	mustPanic(t, func() { enum.DefAll[Level](1, 2) })
+	mustPanic(t, func() { enum.DefLabeled[Level](3, "High") })
+	if err := enum.DefAlias[Level](1, 0); err == nil {
+		t.Error("DefAlias succeeded for frozen enum")
+	}
+	if err := enum.DefAliasName[Level](1, "lo"); err == nil {
+		t.Error("DefAliasName succeeded for frozen enum")
+	}
+	if got := enum.ValuesOf[Level](); len(got) != 1 {
+		t.Errorf("ValuesOf() = %v", got)
+	}
+
+	enum.Clear[Level]()
+	if enum.IsFrozen[Level]() {
+		t.Error("Clear didn't unfreeze")
+	}
+}
+
+func ExampleSeal() {
+	type Shape string
+	enum.DefAll[Shape]("circle", "square")
+	enum.Seal[Shape]()
+	enum.Seal[Shape]() // idempotent
+	fmt.Println(enum.IsSealed[Shape](), enum.IsFrozen[Shape]())
+
+	fmt.Println(enum.TryDef[Shape]("circle"))
+	_, err := enum.TryDef[Shape]("triangle")
+	fmt.Println(err != nil, enum.ValuesOf[Shape]())
+
+	enum.Clear[Shape]()
+	fmt.Println(enum.IsSealed[Shape]())
+	fmt.Println(enum.TryDef[Shape]("triangle"))
+
+	// Output:
+	// true true
+	// circle <nil>
+	// true [circle square]
+	// false
+	// triangle <nil>
+}
+
+func TestFrozenCallerPos(t *testing.T) {
+	type Planet string
+	enum.Def[Planet]("earth")
+	enum.Seal[Planet]()
+	_, err := enum.TryDef[Planet]("pluto")
+	if err == nil || !strings.Contains(err.Error(), "(called at freeze_test.go:") {
+		t.Errorf("got %v, want error mentioning the caller", err)
+	}
+}
@@ -0,0 +1,71 @@
+package enum
+
+import (
+	"encoding/gob"
+	"fmt"
+)
+
+// RegisterGob registers T, Value[T] and Null[T] with gob.Register, so that they can be sent in interface fields,
+// e.g. over net/rpc. Value[T] and Null[T] validate decoded values, unlike bare T.
+// RegisterGob is idempotent, it is meant to be called during initialization, on both sides.
+// Usage:
+//   func init() {
+//     enum.RegisterGob[Status]()
+//   }
+func RegisterGob[T enumType]() {
+	var v T
+	gob.Register(v)
+	gob.Register(Value[T]{})
+	gob.Register(Null[T]{})
+}
+
+// GobEncode implements gob.GobEncoder, it encodes the value in the form accepted by Parse.
+func (v Value[T]) GobEncode() ([]byte, error) {
+	return []byte(toString(v.Val)), nil
+}
+
+// GobDecode implements gob.GobDecoder, it validates the decoded value.
+// That catches values unknown to the decoding process, e.g. sent by a newer version of a service.
+// On failure, the wrapped value is left untouched.
+func (v *Value[T]) GobDecode(data []byte) error {
+	return gobDecode(data, &v.Val)
+}
+
+// GobEncode implements gob.GobEncoder, see Value.GobEncode.
+func (n Null[T]) GobEncode() ([]byte, error) {
+	if !n.Valid {
+		return []byte{0}, nil
+	}
+	return append([]byte{1}, toString(n.Val)...), nil
+}
+
+// GobDecode implements gob.GobDecoder, see Value.GobDecode.
+// On failure, n is left untouched.
+func (n *Null[T]) GobDecode(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("enum: can't decode empty gob into %s", idOf[T]())
+	}
+	if data[0] == 0 {
+		*n = Null[T]{}
+		return nil
+	}
+	var v T
+	if err := gobDecode(data[1:], &v); err != nil {
+		return err
+	}
+	*n = Null[T]{Val: v, Valid: true}
+	return nil
+}
+
+// gobDecode is the inverse of Value.GobEncode, on failure dst is left untouched.
+func gobDecode[T enumType](data []byte, dst *T) error {
+	v, ok := fromString[T](string(data))
+	if !ok {
+		return fmt.Errorf("enum: can't decode gob %q into %s", data, idOf[T]())
+	}
+	if err := Validate(v); err != nil {
+		return err
+	}
+	*dst = v
+	return nil
+}
@@ -0,0 +1,66 @@
+package enum_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleValue_gob() {
+	type Color string
+	enum.DefAll[Color]("red", "green", "blue")
+
+	type Message struct {
+		Color    enum.Value[Color]
+		Fallback enum.Null[Color]
+		Access   enum.Value[Access]
+	}
+	var buf bytes.Buffer
+	msg := Message{
+		Color:    enum.Value[Color]{Val: "blue"},
+		Fallback: enum.Null[Color]{Val: "red", Valid: true},
+		Access:   enum.Value[Access]{Val: AccessWrite},
+	}
+	if err := gob.NewEncoder(&buf).Encode(msg); err != nil {
+		panic(err)
+	}
+	encoded := buf.Bytes()
+
+	var decoded Message
+	err := gob.NewDecoder(bytes.NewReader(encoded)).Decode(&decoded)
+	fmt.Println(decoded, err)
+
+	// the decoding side doesn't know about "blue"
+	enum.Clear[Color]()
+	enum.DefAll[Color]("red", "green")
+	err = gob.NewDecoder(bytes.NewReader(encoded)).Decode(&decoded)
+	fmt.Println(err)
+
+	// Output:
+	// {blue {red true} 4} <nil>
+	// "blue" is not a valid choice, allowed values are: "red", "green"
+}
+
+func ExampleRegisterGob() {
+	enum.RegisterGob[Status]()
+	enum.RegisterGob[Status]() // idempotent
+
+	type Event struct {
+		Payload any
+	}
+	for _, payload := range []any{StatusOpen, enum.Value[Status]{Val: "postponed"}} {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(Event{Payload: payload}); err != nil {
+			panic(err)
+		}
+		var decoded Event
+		err := gob.NewDecoder(&buf).Decode(&decoded)
+		fmt.Println(decoded.Payload, err)
+	}
+
+	// Output:
+	// open <nil>
+	// <nil> "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+}
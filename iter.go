@@ -0,0 +1,36 @@
+package enum
+
+import "iter"
+
+// Iter returns an iterator over defined values of enum T, in the same order as ValuesOf.
+// Unlike ValuesOf, it doesn't copy the values.
+// Values defined after the iteration has started are not yielded.
+func Iter[T enumType]() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range snapshot[T]() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Iter2 is like Iter but also yields zero-based position of each value, see Ordinal.
+func Iter2[T enumType]() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range snapshot[T]() {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// snapshot returns defined values of enum T without copying, the result must not be modified.
+// The lock is not held during iteration, so that yield can call other functions of the package.
+func snapshot[T enumType]() []T {
+	mu.RLock()
+	defer mu.RUnlock()
+	vals, _ := groups[idOf[T]()].([]T)
+	return vals
+}
@@ -0,0 +1,57 @@
+package enum_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleIter() {
+	for status := range enum.Iter[Status]() {
+		if status == StatusClosed {
+			break
+		}
+		fmt.Println(status)
+	}
+
+	// Output:
+	// draft
+	// open
+	// merged
+}
+
+func ExampleIter2() {
+	for i, access := range enum.Iter2[Access]() {
+		fmt.Println(i, access)
+	}
+
+	// Output:
+	// 0 1
+	// 1 2
+	// 2 4
+}
+
+func TestIterDefDuringIteration(t *testing.T) {
+	t.Cleanup(enum.Snapshot())
+	type Counter int
+	enum.Def[Counter](0)
+	n := 0
+	for v := range enum.Iter[Counter]() {
+		enum.Def(v + 1) // must not deadlock
+		n++
+	}
+	if n != 1 {
+		t.Errorf("iterated over %d values, want 1", n)
+	}
+	if c := len(enum.ValuesOf[Counter]()); c != 2 {
+		t.Errorf("defined %d values, want 2", c)
+	}
+}
+
+func BenchmarkIter(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for range enum.Iter[Status]() {
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package enum
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// JSON wraps a value of enum T to validate it when decoding JSON.
+// Integer enums are decoded from JSON numbers, string enums from JSON strings.
+// See Value for a more lenient alternative.
+// Usage:
+//   type Request struct {
+//     Status enum.JSON[Status] `json:"status"`
+//   }
+type JSON[T enumType] struct {
+	Val T
+}
+
+// MarshalJSON encodes the bare value, as if it wasn't wrapped.
+func (j JSON[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(j.Val)
+}
+
+// UnmarshalJSON decodes the value and validates it.
+// On failure, the wrapped value is left untouched.
+func (j *JSON[T]) UnmarshalJSON(data []byte) error {
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if err := Validate(v); err != nil {
+		return err
+	}
+	j.Val = v
+	return nil
+}
+
+// LabelMode controls how Value and Null encode integer enums in JSON, see SetJSONLabels.
+type LabelMode int
+
+const (
+	// NoLabels encodes values as JSON numbers, it is the default.
+	NoLabels LabelMode = iota
+	// LabelsOnly encodes values as their labels, values without a label fail to encode.
+	LabelsOnly
+	// LabelsOrNumbers encodes values as their labels, values without a label as JSON numbers.
+	LabelsOrNumbers
+)
+
+// values are modes given in SetJSONLabels.
+var jsonLabels = map[typeID]LabelMode{}
+
+// SetJSONLabels sets how Value and Null encode values of integer enum T in JSON.
+// Labels given in DefLabeled are readable for API consumers, unlike numbers. They are decoded back regardless of the mode.
+// SetJSONLabels is meant to be called once, during initialization.
+// Usage:
+//   enum.SetJSONLabels[Access](enum.LabelsOnly)
+func SetJSONLabels[T intEnumType](mode LabelMode) {
+	typ := idOf[T]()
+	mu.Lock()
+	defer mu.Unlock()
+	if mode == NoLabels {
+		delete(jsonLabels, typ)
+		return
+	}
+	jsonLabels[typ] = mode
+}
+
+// marshalJSON encodes v as a label or as the bare value, depending on the mode given in SetJSONLabels.
+func marshalJSON[T enumType](v T) ([]byte, error) {
+	typ := idOf[T]()
+	mu.RLock()
+	mode := jsonLabels[typ]
+	c, _ := canonical(typ, v)
+	label, labeled := labels[typeValue[T]{typ: typ, val: c}]
+	mu.RUnlock()
+	switch {
+	case mode == NoLabels:
+		return json.Marshal(v)
+	case labeled:
+		return json.Marshal(label)
+	case mode == LabelsOrNumbers:
+		return json.Marshal(v)
+	default:
+		return nil, fmt.Errorf("enum: %s %s doesn't have a label", typ.Name(), toString(v))
+	}
+}
+
+// unmarshalJSON decodes data into dst and validates it.
+// JSON null is ignored, integer enums accept quoted numbers, names and labels as well.
+// On failure, dst is left untouched.
+func unmarshalJSON[T enumType](data []byte, dst *T) error {
+	if string(data) == "null" {
+		return nil // by convention, null is a no-op
+	}
+	var v T
+	if len(data) > 0 && data[0] == '"' && idOf[T]().Kind() != reflect.String {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		parsed, err := Parse[T](s)
+		if err != nil {
+			labeled, ok := FromLabel[T](s)
+			if !ok {
+				return err
+			}
+			parsed = labeled
+		}
+		v = parsed
+	} else {
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		if err := Validate(v); err != nil {
+			return err
+		}
+	}
+	*dst = v
+	return nil
+}
+
+// UnmarshalJSONValue decodes JSON data into dst and validates it, it is meant for hand-written UnmarshalJSON methods.
+// Integer enums are decoded from JSON numbers, string enums from JSON strings.
+// JSON null is ignored. On failure, dst is left untouched and the error mentions data.
+// Usage:
+//   func (s *Status) UnmarshalJSON(data []byte) error {
+//     return enum.UnmarshalJSONValue(data, s)
+//   }
+func UnmarshalJSONValue[T enumType](data []byte, dst *T) error {
+	data = bytes.TrimSpace(data)
+	if string(data) == "null" {
+		return nil // by convention, null is a no-op
+	}
+	typ := idOf[T]()
+	// T is not decoded with json.Unmarshal directly, since it would call UnmarshalJSON of T recursively.
+	var (
+		v  T
+		ok bool
+	)
+	if typ.Kind() == reflect.String {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return fmt.Errorf("enum: can't unmarshal JSON %s into %s: %w", data, typ, err)
+		}
+		v, ok = fromString[T](s)
+	} else {
+		v, ok = fromString[T](string(data))
+	}
+	if !ok {
+		return fmt.Errorf("enum: can't unmarshal JSON %s into %s", data, typ)
+	}
+	if err := Validate(v); err != nil {
+		return fmt.Errorf("enum: can't unmarshal JSON %s into %s: %w", data, typ, err)
+	}
+	*dst = v
+	return nil
+}
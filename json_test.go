@@ -0,0 +1,126 @@
+package enum_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleJSON() {
+	type PullRequest struct {
+		Status enum.JSON[Status] `json:"status"`
+		Access enum.JSON[Access] `json:"access"`
+	}
+
+	var pr PullRequest
+	err := json.Unmarshal([]byte(`{"status": "open", "access": 2}`), &pr)
+	fmt.Println(pr.Status.Val, pr.Access.Val, err)
+
+	b, _ := json.Marshal(pr)
+	fmt.Println(string(b))
+
+	for _, input := range []string{
+		`{"status": "postponed"}`,
+		`{"access": 99}`,
+		`{"access": "2"}`,
+	} {
+		fmt.Println(json.Unmarshal([]byte(input), &pr))
+	}
+
+	// Output:
+	// open 2 <nil>
+	// {"status":"open","access":2}
+	// "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// 99 is not a valid choice, allowed values are: 1, 2, 4
+	// json: cannot unmarshal string into Go value of type enum_test.Access
+}
+
+type Visibility string
+
+var (
+	VisibilityPublic  = enum.Def[Visibility]("public")
+	VisibilityPrivate = enum.Def[Visibility]("private")
+)
+
+func (v *Visibility) UnmarshalJSON(data []byte) error {
+	return enum.UnmarshalJSONValue(data, v)
+}
+
+func ExampleUnmarshalJSONValue() {
+	var repo struct {
+		Visibility Visibility `json:"visibility"`
+	}
+	fmt.Println(json.Unmarshal([]byte(`{"visibility": "private"}`), &repo), repo.Visibility)
+	fmt.Println(json.Unmarshal([]byte(`{"visibility": "internal"}`), &repo), repo.Visibility)
+	fmt.Println(json.Unmarshal([]byte(`{"visibility": 1}`), &repo), repo.Visibility)
+
+	var access Access
+	fmt.Println(enum.UnmarshalJSONValue([]byte(`4`), &access), access)
+	fmt.Println(enum.UnmarshalJSONValue([]byte(`"4"`), &access), access)
+	fmt.Println(enum.UnmarshalJSONValue([]byte(`3`), &access), access)
+
+	// Output:
+	// <nil> private
+	// enum: can't unmarshal JSON "internal" into enum_test.Visibility: "internal" is not a valid choice, allowed values are: "public", "private" private
+	// enum: can't unmarshal JSON 1 into enum_test.Visibility: json: cannot unmarshal number into Go value of type string private
+	// <nil> 4
+	// enum: can't unmarshal JSON "4" into enum_test.Access 4
+	// enum: can't unmarshal JSON 3 into enum_test.Access: 3 is not a valid choice, allowed values are: 1, 2, 4 4
+}
+
+func ExampleSetJSONLabels() {
+	type Permission int
+	var (
+		PermissionRead  = enum.DefLabeled[Permission](1, "read")
+		PermissionWrite = enum.DefLabeled[Permission](4, "write")
+		PermissionAdmin = enum.DefLabeled[Permission](16, "admin")
+		PermissionOwner = enum.Def[Permission](32)
+	)
+	enum.SetJSONLabels[Permission](enum.LabelsOnly)
+
+	for _, p := range []Permission{PermissionRead, PermissionWrite, PermissionAdmin, PermissionOwner} {
+		data, err := enum.Value[Permission]{Val: p}.MarshalJSON()
+		fmt.Println(string(data), err)
+	}
+
+	enum.SetJSONLabels[Permission](enum.LabelsOrNumbers)
+	data, _ := json.Marshal([]enum.Value[Permission]{{Val: PermissionAdmin}, {Val: PermissionOwner}})
+	fmt.Println(string(data))
+
+	var decoded []enum.Value[Permission]
+	fmt.Println(json.Unmarshal(data, &decoded), decoded)
+
+	// Output:
+	// "read" <nil>
+	// "write" <nil>
+	// "admin" <nil>
+	//  enum: Permission 32 doesn't have a label
+	// ["admin",32]
+	// <nil> [admin 32]
+}
+
+func TestSetJSONLabelsRoundTrip(t *testing.T) {
+	type Level uint8
+	levels := map[Level]string{1: "low", 5: "medium", 50: "high", 200: "critical"}
+	for _, v := range []Level{1, 5, 50, 200} {
+		enum.DefLabeled(v, levels[v])
+	}
+	enum.SetJSONLabels[Level](enum.LabelsOnly)
+	for _, v := range enum.ValuesOf[Level]() {
+		data, err := json.Marshal(enum.Null[Level]{Val: v, Valid: true})
+		if err != nil || string(data) != `"`+levels[v]+`"` {
+			t.Errorf("Marshal(%d) = %s, %v", v, data, err)
+		}
+		var got enum.Null[Level]
+		if err := json.Unmarshal(data, &got); err != nil || got.Val != v {
+			t.Errorf("Unmarshal(%s) = %d, %v", data, got.Val, err)
+		}
+	}
+	enum.Clear[Level]()
+	enum.Def[Level](5)
+	if data, err := json.Marshal(enum.Value[Level]{Val: 5}); string(data) != "5" {
+		t.Errorf("Marshal(5) after Clear = %s, %v", data, err)
+	}
+}
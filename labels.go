@@ -0,0 +1,91 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+)
+
+// keys are always typeValue[enumType], values are labels given in DefLabeled.
+var labels = map[any]string{}
+
+// values are always enumType, reverse index of labels.
+var labeled = map[typeName]any{}
+
+// values are always enumType, reverse index of lower-cased labels.
+var labeledFold = map[typeName]any{}
+
+// DefLabeled defines v as a valid value of enum T with a human-readable label and returns it.
+// Unlike names, labels are meant for display only and are not accepted by Parse.
+// Label is shown next to the value in error messages of integer enums, unless the value is named.
+// Labeling an already labeled value differently, or giving labels that differ only by case to two values, panics.
+// Usage:
+//   var StatusWIP = enum.DefLabeled[Status]("wip", "Work In Progress")
+func DefLabeled[T enumType](v T, label string) T {
+	typID := idOf[T]()
+	vKey := typeValue[T]{val: v, typ: typID}
+	mu.Lock()
+	defer mu.Unlock()
+	fKey := typeName{typ: typID, name: strings.ToLower(label)}
+	if old, ok := labels[vKey]; ok && old != label {
+		panic(fmt.Sprintf("%s: can't label %s %q, it is already labeled %q", typID.Name(), toString(v), label, old))
+	}
+	if old, ok := labeledFold[fKey]; ok && old.(T) != v {
+		panic(fmt.Sprintf("%s: can't label %s %q, the label is already taken by %s", typID.Name(), toString(v), label, toString(old.(T))))
+	}
+	def(typID, v)
+	labels[vKey] = label
+	changed(typID)
+	labeled[typeName{typ: typID, name: label}] = v
+	labeledFold[fKey] = v
+	return v
+}
+
+// LabelOf returns the label given to v in DefLabeled.
+// Reports false if v has no label.
+func LabelOf[T enumType](v T) (string, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	label, ok := labels[typeValue[T]{typ: idOf[T](), val: v}]
+	return label, ok
+}
+
+// FromLabel returns the value labeled with label in DefLabeled.
+// Reports false if there is no such value.
+func FromLabel[T enumType](label string) (T, bool) {
+	return fromLabel[T](labeled, label)
+}
+
+// FromLabelFold is like FromLabel but matches label case-insensitively.
+func FromLabelFold[T enumType](label string) (T, bool) {
+	return fromLabel[T](labeledFold, strings.ToLower(label))
+}
+
+func fromLabel[T enumType](index map[typeName]any, label string) (T, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	v, ok := index[typeName{typ: idOf[T](), name: label}]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return v.(T), true
+}
+
+// String returns v for display: its label given in DefLabeled, or its name given in DefNamed,
+// or the form accepted by Parse otherwise. Aliases are shown as their canonical values.
+// It ignores String method of T, so it can be used to implement one:
+//   func (s Status) String() string {
+//     return enum.String(s)
+//   }
+func String[T enumType](v T) string {
+	typ := idOf[T]()
+	mu.RLock()
+	defer mu.RUnlock()
+	if c, ok := canonical(typ, v); ok {
+		v = c
+	}
+	if label, ok := labels[typeValue[T]{typ: typ, val: v}]; ok {
+		return label
+	}
+	return nameOrString(typ, v)
+}
@@ -0,0 +1,85 @@
+package enum_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleDefLabeled() {
+	type TaskStatus string
+	var (
+		TaskStatusTodo = enum.Def[TaskStatus]("todo")
+		TaskStatusWIP  = enum.DefLabeled[TaskStatus]("wip", "Work In Progress")
+	)
+
+	fmt.Println(enum.LabelOf(TaskStatusWIP))
+	fmt.Println(enum.LabelOf(TaskStatusTodo))
+	fmt.Println(enum.ValuesOf[TaskStatus]())
+
+	// Output:
+	// Work In Progress true
+	//  false
+	// [todo wip]
+}
+
+func TestDefLabeledConflict(t *testing.T) {
+	type Priority int
+	enum.DefLabeled[Priority](1, "Low")
+	enum.DefLabeled[Priority](1, "Low")
+	mustPanic(t, func() { enum.DefLabeled[Priority](1, "Minor") })
+}
+
+func ExampleFromLabel() {
+	type TaskStatus string
+	enum.DefLabeled[TaskStatus]("todo", "To Do")
+	enum.DefLabeled[TaskStatus]("wip", "Work In Progress")
+
+	fmt.Println(enum.FromLabel[TaskStatus]("Work In Progress"))
+	fmt.Println(enum.FromLabel[TaskStatus]("work in progress"))
+	fmt.Println(enum.FromLabelFold[TaskStatus]("work in progress"))
+	fmt.Println(enum.FromLabelFold[TaskStatus]("Done"))
+
+	// Output:
+	// wip true
+	//  false
+	// wip true
+	//  false
+}
+
+func TestDefLabeledSharedLabel(t *testing.T) {
+	type Priority int
+	enum.DefLabeled[Priority](1, "Low")
+	mustPanic(t, func() { enum.DefLabeled[Priority](2, "Low") })
+	mustPanic(t, func() { enum.DefLabeled[Priority](2, "LOW") })
+	if v, ok := enum.FromLabel[Priority]("Low"); !ok || v != 1 {
+		t.Errorf("FromLabel(Low) = %v, %v", v, ok)
+	}
+}
+
+type Phase string
+
+var (
+	PhasePlanned = enum.DefLabeled[Phase]("planned", "Planned")
+	PhaseWIP     = enum.DefLabeled[Phase]("wip", "Work In Progress")
+	PhaseDone    = enum.Def[Phase]("done")
+)
+
+func (p Phase) String() string {
+	return enum.String(p)
+}
+
+func ExampleString() {
+	_ = enum.DefAlias(PhaseWIP, "in_progress")
+	fmt.Println(PhasePlanned, PhaseWIP, PhaseDone)
+	fmt.Printf("%v %s\n", Phase("in_progress"), Phase("cancelled"))
+
+	type Level int
+	enum.DefNamed[Level](1, "low")
+	fmt.Println(enum.String[Level](1), enum.String[Level](2))
+	// Output:
+	// Planned Work In Progress done
+	// Work In Progress cancelled
+	// low 2
+}
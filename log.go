@@ -0,0 +1,41 @@
+package enum
+
+import (
+	"log/slog"
+	"reflect"
+)
+
+// LogValue returns v for structured logging: a group of "value" and "label" if v is labeled via DefLabeled,
+// otherwise the bare value. Aliases are logged as their canonical values. String method of T is ignored.
+// Usage:
+//   logger.Info("access granted", "access", enum.LogValue(access))
+func LogValue[T enumType](v T) slog.Value {
+	typ := idOf[T]()
+	mu.RLock()
+	c, _ := canonical(typ, v)
+	label, labeled := labels[typeValue[T]{typ: typ, val: c}]
+	mu.RUnlock()
+	raw := rawLogValue(c)
+	if !labeled {
+		return raw
+	}
+	return slog.GroupValue(slog.Attr{Key: "value", Value: raw}, slog.String("label", label))
+}
+
+// LogValue implements slog.LogValuer, see LogValue function.
+func (v Value[T]) LogValue() slog.Value {
+	return LogValue(v.Val)
+}
+
+// rawLogValue returns v as a value of its underlying type.
+func rawLogValue[T enumType](v T) slog.Value {
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.Kind() == reflect.String:
+		return slog.StringValue(rv.String())
+	case rv.CanInt():
+		return slog.Int64Value(rv.Int())
+	default: // enumType permits only strings and integers
+		return slog.Uint64Value(rv.Uint())
+	}
+}
@@ -0,0 +1,29 @@
+package enum_test
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleLogValue() {
+	type Role int
+	enum.DefLabeled[Role](1, "Viewer")
+	enum.Def[Role](2)
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("granted", "role", enum.LogValue[Role](1), "status", enum.LogValue(StatusOpen))
+	logger.Info("granted", "role", enum.Wrap[Role](2))
+
+	// Output:
+	// level=INFO msg=granted role.value=1 role.label=Viewer status=open
+	// level=INFO msg=granted role=2
+}
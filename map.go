@@ -0,0 +1,100 @@
+package enum
+
+import (
+	"iter"
+	"slices"
+)
+
+// EnumMap maps values of enum T to values of V, iterating in the same order as ValuesOf.
+// It helps to keep per-value lookup tables complete, see MissingKeys.
+// The zero value is an empty map ready to use.
+// EnumMap is not safe for concurrent use.
+type EnumMap[T enumType, V any] struct {
+	m map[T]V
+}
+
+// Set maps k to v, without checking whether k is defined.
+func (m *EnumMap[T, V]) Set(k T, v V) {
+	if m.m == nil {
+		m.m = map[T]V{}
+	}
+	m.m[k] = v
+}
+
+// Get returns the value mapped to k, and reports whether k is in the map.
+func (m *EnumMap[T, V]) Get(k T) (V, bool) {
+	v, ok := m.m[k]
+	return v, ok
+}
+
+// Delete removes k from the map.
+func (m *EnumMap[T, V]) Delete(k T) {
+	delete(m.m, k)
+}
+
+// Len returns the number of keys in the map.
+func (m *EnumMap[T, V]) Len() int {
+	return len(m.m)
+}
+
+// Keys returns keys of the map in the same order as ValuesOf.
+// Other keys, i.e. undefined values and aliases, if any were set, come last in ascending order.
+// It is safe to modify the returned slice.
+func (m *EnumMap[T, V]) Keys() []T {
+	return orderedKeys(m.m)
+}
+
+// orderedKeys returns keys of m in the same order as ValuesOf, followed by other keys in ascending order.
+func orderedKeys[T enumType, V any](m map[T]V) []T {
+	keys := make([]T, 0, len(m))
+	for _, k := range ValuesOf[T]() {
+		if _, ok := m[k]; ok {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == len(m) {
+		return keys
+	}
+	n := len(keys)
+	emitted := make(map[T]struct{}, n)
+	for _, k := range keys {
+		emitted[k] = struct{}{}
+	}
+	for k := range m {
+		if _, ok := emitted[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys[n:])
+	return keys
+}
+
+// All returns an iterator over key-value pairs of the map, in the same order as Keys.
+// Keys are collected before the iteration starts.
+func (m *EnumMap[T, V]) All() iter.Seq2[T, V] {
+	return func(yield func(T, V) bool) {
+		for _, k := range m.Keys() {
+			v, ok := m.m[k]
+			if ok && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// MissingKeys returns defined values of enum T that are not in the map, in the same order as ValuesOf.
+// Usage:
+//   func TestTimeouts(t *testing.T) {
+//     if missing := timeouts.MissingKeys(); len(missing) > 0 {
+//       t.Errorf("no timeout for %v", missing)
+//     }
+//   }
+func (m *EnumMap[T, V]) MissingKeys() []T {
+	var missing []T
+	for _, k := range ValuesOf[T]() {
+		if _, ok := m.m[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,58 @@
+package enum_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleEnumMap() {
+	var timeouts enum.EnumMap[Status, time.Duration]
+	timeouts.Set(StatusOpen, time.Hour)
+	timeouts.Set(StatusDraft, 0)
+	timeouts.Set("postponed", time.Minute)
+
+	for status, timeout := range timeouts.All() {
+		fmt.Println(status, timeout)
+	}
+	fmt.Println(timeouts.Get(StatusDraft))
+	fmt.Println(timeouts.Get(StatusMerged))
+	fmt.Println(timeouts.MissingKeys())
+
+	timeouts.Delete("postponed")
+	fmt.Println(timeouts.Keys(), timeouts.Len())
+
+	// Output:
+	// draft 0s
+	// open 1h0m0s
+	// postponed 1m0s
+	// 0s true
+	// 0s false
+	// [merged closed]
+	// [draft open] 2
+}
+
+func TestEnumMapAliasKeys(t *testing.T) {
+	type Color string
+	enum.DefAll[Color]("gray", "black")
+	if err := enum.DefAlias[Color]("gray", "grey"); err != nil {
+		t.Fatal(err)
+	}
+	var m enum.EnumMap[Color, int]
+	m.Set("black", 0)
+	m.Set("grey", 1)
+	m.Set("white", 2)
+	if got, want := m.Keys(), []Color{"black", "grey", "white"}; !slices.Equal(got, want) {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+	n := 0
+	for range m.All() {
+		n++
+	}
+	if n != m.Len() {
+		t.Errorf("All() yielded %d pairs, want %d", n, m.Len())
+	}
+}
@@ -0,0 +1,33 @@
+package enum
+
+import "maps"
+
+// keys are always typeValue[enumType], values are metadata given in DefWith.
+var metas = map[any]map[string]any{}
+
+// DefWith defines v as a valid value of enum T with arbitrary metadata, e.g. an icon name, and returns it.
+// Metadata is not used by the package, it lets callers describe values where they are defined.
+// Metadata of an already defined value is merged, values of the same keys are replaced.
+// Usage:
+//   var StatusDraft = enum.DefWith[Status]("draft", map[string]any{"icon": "pencil", "weight": 10})
+func DefWith[T enumType](v T, meta map[string]any) T {
+	typID := idOf[T]()
+	vKey := typeValue[T]{val: v, typ: typID}
+	mu.Lock()
+	defer mu.Unlock()
+	def(typID, v)
+	if metas[vKey] == nil {
+		metas[vKey] = make(map[string]any, len(meta))
+	}
+	maps.Copy(metas[vKey], meta)
+	return v
+}
+
+// MetaOf returns metadata given to v in DefWith.
+// Reports false if v has no metadata. It is safe to modify the returned map, but not values it holds by reference.
+func MetaOf[T enumType](v T) (map[string]any, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	meta, ok := metas[typeValue[T]{typ: idOf[T](), val: v}]
+	return maps.Clone(meta), ok
+}
@@ -0,0 +1,31 @@
+package enum_test
+
+import (
+	"fmt"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleDefWith() {
+	type Channel string
+	var (
+		ChannelEmail = enum.DefWith[Channel]("email", map[string]any{"icon": "envelope"})
+		ChannelSMS   = enum.DefWith[Channel]("sms", map[string]any{"icon": "phone", "deprecated": true})
+		ChannelPush  = enum.Def[Channel]("push")
+	)
+	enum.DefWith(ChannelEmail, map[string]any{"weight": 10})
+
+	for _, c := range []Channel{ChannelEmail, ChannelSMS, ChannelPush} {
+		meta, ok := enum.MetaOf(c)
+		fmt.Println(c, meta, ok)
+	}
+
+	meta, _ := enum.MetaOf(ChannelSMS)
+	meta["deprecated"] = false
+	fmt.Println(enum.MetaOf(ChannelSMS))
+	// Output:
+	// email map[icon:envelope weight:10] true
+	// sms map[deprecated:true icon:phone] true
+	// push map[] false
+	// map[deprecated:true icon:phone] true
+}
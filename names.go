@@ -0,0 +1,68 @@
+package enum
+
+import "reflect"
+
+// NameOf returns the name given to v in DefNamed.
+// String enums are named by their values unless named explicitly.
+// Reports false if v isn't defined for enum T or has no name.
+func NameOf[T enumType](v T) (string, bool) {
+	typ := idOf[T]()
+	vKey := typeValue[T]{typ: typ, val: v}
+	mu.RLock()
+	_, defined := defs[vKey]
+	name, named := names[vKey]
+	mu.RUnlock()
+	switch {
+	case !defined:
+		return "", false
+	case named:
+		return name, true
+	case typ.Kind() == reflect.String:
+		return reflect.ValueOf(&v).Elem().String(), true
+	default:
+		return "", false
+	}
+}
+
+// Names returns names of defined values of enum T, in the same order as ValuesOf.
+// Values without a name are represented in the form accepted by Parse, e.g. decimal for integers.
+// It is safe to modify the returned slice.
+func Names[T enumType]() []string {
+	typ := idOf[T]()
+	mu.RLock()
+	defer mu.RUnlock()
+	vals, ok := groups[typ].([]T)
+	if !ok {
+		return nil
+	}
+	s := make([]string, len(vals))
+	for i, v := range vals {
+		s[i] = nameOrString(typ, v)
+	}
+	return s
+}
+
+// NameMap returns a map from each defined value of enum T to its name, see Names.
+// It is safe to modify the returned map.
+func NameMap[T enumType]() map[T]string {
+	typ := idOf[T]()
+	mu.RLock()
+	defer mu.RUnlock()
+	vals, ok := groups[typ].([]T)
+	if !ok {
+		return nil
+	}
+	m := make(map[T]string, len(vals))
+	for _, v := range vals {
+		m[v] = nameOrString(typ, v)
+	}
+	return m
+}
+
+// nameOrString returns the name of v, or its form accepted by Parse if v has no name, mu must be held.
+func nameOrString[T enumType](typ typeID, v T) string {
+	if name, ok := names[typeValue[T]{typ: typ, val: v}]; ok {
+		return name
+	}
+	return toString(v)
+}
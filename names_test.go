@@ -0,0 +1,69 @@
+package enum_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleNameOf() {
+	type Permission int
+	enum.DefNamed[Permission](1, "read")
+	enum.Def[Permission](2)
+
+	for _, p := range []Permission{1, 2, 3} {
+		fmt.Println(enum.NameOf(p))
+	}
+	fmt.Println(enum.NameOf(StatusMerged))
+	fmt.Println(enum.NameOf(Status("postponed")))
+
+	// Output:
+	// read true
+	//  false
+	//  false
+	// merged true
+	//  false
+}
+
+func TestNameOfAllocs(t *testing.T) {
+	type Permission int
+	enum.DefNamed[Permission](1000, "read")
+	if n := testing.AllocsPerRun(100, func() { enum.NameOf[Permission](1000) }); n != 0 {
+		t.Errorf("NameOf allocates %v times for integer enum", n)
+	}
+	if n := testing.AllocsPerRun(100, func() { enum.NameOf(StatusMerged) }); n != 0 {
+		t.Errorf("NameOf allocates %v times for string enum", n)
+	}
+}
+
+func ExampleNames() {
+	type Permission int
+	enum.DefNamed[Permission](1, "read")
+	enum.Def[Permission](2)
+	enum.DefNamed[Permission](4, "write")
+
+	fmt.Printf("%q\n", enum.Names[Permission]())
+	fmt.Printf("%q\n", enum.Names[Status]())
+
+	// Output:
+	// ["read" "2" "write"]
+	// ["draft" "open" "merged" "closed"]
+}
+
+func ExampleNameMap() {
+	type Permission int
+	enum.DefNamed[Permission](1, "read")
+	enum.Def[Permission](2)
+
+	fmt.Println(enum.NameMap[Permission]())
+	fmt.Println(enum.NameMap[Status]())
+
+	type Nothing int
+	fmt.Println(enum.NameMap[Nothing]() == nil)
+
+	// Output:
+	// map[1:read 2:2]
+	// map[closed:closed draft:draft merged:merged open:open]
+	// true
+}
@@ -0,0 +1,67 @@
+package enum
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// Null is a nullable value of enum T, similar to sql.Null.
+// Non-null values are validated both when encoding and decoding.
+type Null[T enumType] struct {
+	Val   T
+	Valid bool // Valid is true if Val is not NULL
+}
+
+// Value implements driver.Valuer, it returns nil for NULL and the underlying value otherwise.
+// Returns an error if Val is not defined for enum T.
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	if err := Validate(n.Val); err != nil {
+		return nil, err
+	}
+	return driverValue(n.Val)
+}
+
+// Scan implements sql.Scanner, NULL is scanned as invalid, other values are validated, see SQL.Scan.
+// On failure, n is left untouched.
+func (n *Null[T]) Scan(src any) error {
+	if src == nil {
+		*n = Null[T]{}
+		return nil
+	}
+	var v T
+	if err := scan(src, &v); err != nil {
+		return err
+	}
+	*n = Null[T]{Val: v, Valid: true}
+	return nil
+}
+
+// MarshalJSON encodes NULL as JSON null and the bare value or its label otherwise, see SetJSONLabels.
+// Returns an error if Val is not defined for enum T.
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	if err := Validate(n.Val); err != nil {
+		return nil, err
+	}
+	return marshalJSON(n.Val)
+}
+
+// UnmarshalJSON decodes JSON null as NULL and validates other values, see Value.UnmarshalJSON.
+// On failure, n is left untouched.
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		*n = Null[T]{}
+		return nil
+	}
+	var v T
+	if err := unmarshalJSON(data, &v); err != nil {
+		return err
+	}
+	*n = Null[T]{Val: v, Valid: true}
+	return nil
+}
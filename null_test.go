@@ -0,0 +1,42 @@
+package enum_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleNull() {
+	var status enum.Null[Status]
+	fmt.Println(status.Scan(nil), status)
+	fmt.Println(status.Scan("open"), status)
+	fmt.Println(status.Scan("postponed"), status)
+	fmt.Println(status.Value())
+
+	var pr struct {
+		Status enum.Null[Status] `json:"status"`
+	}
+	fmt.Println(json.Unmarshal([]byte(`{"status": null}`), &pr), pr.Status)
+	fmt.Println(json.Unmarshal([]byte(`{"status": "merged"}`), &pr), pr.Status)
+	b, err := json.Marshal(pr)
+	fmt.Println(string(b), err)
+
+	pr.Status = enum.Null[Status]{Val: "postponed", Valid: true}
+	_, err = json.Marshal(pr)
+	fmt.Println(errors.As(err, new(*enum.ValidationError)))
+	_, err = pr.Status.Value()
+	fmt.Println(err)
+
+	// Output:
+	// <nil> { false}
+	// <nil> {open true}
+	// "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed" {open true}
+	// open <nil>
+	// <nil> { false}
+	// <nil> {merged true}
+	// {"status":"merged"} <nil>
+	// true
+	// "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+}
@@ -0,0 +1,67 @@
+package enum
+
+// Ordinal returns zero-based position of v in ValuesOf[T].
+// Reports false if v isn't defined for enum T.
+func Ordinal[T enumType](v T) (int, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	i, ok := defs[typeValue[T]{typ: idOf[T](), val: v}]
+	return i, ok
+}
+
+// ByOrdinal returns the value at zero-based position i in ValuesOf[T].
+// Reports false if i is out of range.
+func ByOrdinal[T enumType](i int) (T, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	vals, _ := groups[idOf[T]()].([]T)
+	if i < 0 || i >= len(vals) {
+		var zero T
+		return zero, false
+	}
+	return vals[i], true
+}
+
+// Next returns the value defined right after v.
+// Reports false if v is the last value or isn't defined for enum T.
+func Next[T enumType](v T) (T, bool) {
+	return step(v, 1, false)
+}
+
+// Prev returns the value defined right before v.
+// Reports false if v is the first value or isn't defined for enum T.
+func Prev[T enumType](v T) (T, bool) {
+	return step(v, -1, false)
+}
+
+// NextCyclic is like Next, but returns the first value for the last one.
+func NextCyclic[T enumType](v T) (T, bool) {
+	return step(v, 1, true)
+}
+
+// PrevCyclic is like Prev, but returns the last value for the first one.
+func PrevCyclic[T enumType](v T) (T, bool) {
+	return step(v, -1, true)
+}
+
+// step returns the value at distance delta from v in definition order.
+func step[T enumType](v T, delta int, cyclic bool) (T, bool) {
+	typ := idOf[T]()
+	mu.RLock()
+	defer mu.RUnlock()
+	i, ok := defs[typeValue[T]{typ: typ, val: v}]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	vals := groups[typ].([]T)
+	i += delta
+	if cyclic {
+		i = (i + len(vals)) % len(vals)
+	}
+	if i < 0 || i >= len(vals) {
+		var zero T
+		return zero, false
+	}
+	return vals[i], true
+}
@@ -0,0 +1,62 @@
+package enum_test
+
+import (
+	"fmt"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleOrdinal() {
+	fmt.Println(enum.Ordinal(StatusDraft))
+	fmt.Println(enum.Ordinal(StatusClosed))
+	fmt.Println(enum.Ordinal(Status("postponed")))
+
+	// Output:
+	// 0 true
+	// 3 true
+	// 0 false
+}
+
+func ExampleByOrdinal() {
+	fmt.Println(enum.ByOrdinal[Status](1))
+	fmt.Println(enum.ByOrdinal[Access](2))
+	fmt.Println(enum.ByOrdinal[Access](3))
+	fmt.Println(enum.ByOrdinal[Access](-1))
+
+	// Output:
+	// open true
+	// 4 true
+	// 0 false
+	// 0 false
+}
+
+func ExampleNext() {
+	fmt.Println(enum.Next(StatusDraft))
+	fmt.Println(enum.Next(StatusClosed))
+	fmt.Println(enum.NextCyclic(StatusClosed))
+	fmt.Println(enum.Next(Status("postponed")))
+
+	// Output:
+	// open true
+	//  false
+	// draft true
+	//  false
+}
+
+func ExamplePrev() {
+	fmt.Println(enum.Prev(AccessWrite))
+	fmt.Println(enum.Prev(AccessRead))
+	fmt.Println(enum.PrevCyclic(AccessRead))
+
+	type Single int
+	enum.Def[Single](7)
+	fmt.Println(enum.Prev[Single](7))
+	fmt.Println(enum.PrevCyclic[Single](7))
+
+	// Output:
+	// 2 true
+	// 0 false
+	// 4 true
+	// 0 false
+	// 7 true
+}
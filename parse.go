@@ -0,0 +1,209 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Parse converts s to a defined value of enum T.
+// String enums accept the value as-is, integer enums accept its decimal representation.
+// Names given in DefNamed and aliases are accepted as well, canonical value is returned for the latter.
+// If s doesn't match any definition, returns zero value and an error listing allowed values,
+// with a hint if s looks like a misspelling of one of them (see DisableSuggestions).
+func Parse[T enumType](s string) (T, error) {
+	typ := idOf[T]()
+	v, ok := fromString[T](s)
+	mu.RLock()
+	defer mu.RUnlock()
+	if ok {
+		if c, valueExists := canonical(typ, v); valueExists {
+			return c, nil
+		}
+	}
+	if v, ok := named[typeName{typ: typ, name: s}]; ok {
+		return v.(T), nil
+	}
+	var zero T
+	if ok && isDisallowedZero(typ, v) {
+		return zero, invalidErr(typ, v)
+	}
+	err := validationErr(typ, v, fmt.Sprintf("%q", s))
+	if e := err.(*ValidationError); e.allowed != nil {
+		e.setHintOf(parseHintOf[T](s))
+	}
+	return zero, err
+}
+
+// fromString converts s to T without validation.
+// Reports false if s can't be represented as T, e.g. "abc" or "300" for ~int8.
+func fromString[T enumType](s string) (v T, ok bool) {
+	rv := reflect.ValueOf(&v).Elem()
+	switch {
+	case rv.Kind() == reflect.String:
+		rv.SetString(s)
+	case rv.CanInt():
+		n, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		if err != nil {
+			return v, false
+		}
+		rv.SetInt(n)
+	default: // enumType permits only strings and integers
+		n, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		if err != nil {
+			return v, false
+		}
+		rv.SetUint(n)
+	}
+	return v, true
+}
+
+// MustParse is like Parse but panics if s doesn't match any definition.
+// It simplifies safe initialization of global variables holding enum values.
+func MustParse[T enumType](s string) T {
+	v, err := Parse[T](s)
+	if err != nil {
+		panic(fmt.Sprintf("enum: MustParse[%s]: %v", idOf[T]().Name(), err))
+	}
+	return v
+}
+
+// ParseOrDefault is like Parse but returns fallback instead of an error if s doesn't match any definition.
+// fallback is trusted, it is returned as-is even if it is not defined for enum T.
+// Usage:
+//   status := enum.ParseOrDefault(os.Getenv("DEFAULT_STATUS"), StatusDraft)
+func ParseOrDefault[T enumType](s string, fallback T) T {
+	v, err := Parse[T](s)
+	if err != nil {
+		return fallback
+	}
+	return v
+}
+
+// stringEnumType is enumType restricted to strings, e.g. for case-insensitive matching.
+type stringEnumType interface {
+	~string
+}
+
+// ParseFold is like Parse but matches s against defined values and their aliases ignoring ASCII case.
+// Returns the defined value, not s, e.g. "DRAFT" is parsed as "draft".
+// If s matches several values that differ only by case, exact match is preferred,
+// otherwise an error is returned instead of picking one of them.
+func ParseFold[T stringEnumType](s string) (T, error) {
+	typ := idOf[T]()
+	mu.RLock()
+	defer mu.RUnlock()
+	matches := foldMatches[T](typ, s)
+	var zero T
+	switch len(matches) {
+	case 0:
+		return zero, validationErr(typ, T(s), fmt.Sprintf("%q", s))
+	case 1:
+		return matches[0], nil
+	default:
+		return zero, errors.New(ambiguityMsg(typ, s, matches))
+	}
+}
+
+// IsValidFold reports whether v matches a defined value of enum T or its alias ignoring ASCII case, see NormalizeFold.
+func IsValidFold[T stringEnumType](v T) bool {
+	_, ok := NormalizeFold(v)
+	return ok
+}
+
+// NormalizeFold returns the defined value of enum T that v matches ignoring ASCII case, e.g. "draft" for "Draft".
+// Aliases are matched as well, their canonical value is returned.
+// Reports false if v doesn't match any value, or matches several values that differ only by case and none exactly.
+// Usage:
+//   if status, ok := enum.NormalizeFold(req.Status); ok {
+//     req.Status = status
+//   }
+func NormalizeFold[T stringEnumType](v T) (T, bool) {
+	typ := idOf[T]()
+	mu.RLock()
+	defer mu.RUnlock()
+	if matches := foldMatches[T](typ, string(v)); len(matches) == 1 {
+		return matches[0], true
+	}
+	return v, false
+}
+
+// foldMatches returns defined values of enum typ that s or their aliases match ignoring ASCII case,
+// or only the value s is or aliases if there is one, mu must be held.
+func foldMatches[T stringEnumType](typ typeID, s string) []T {
+	if c, ok := canonical(typ, T(s)); ok {
+		return []T{c}
+	}
+	return folded[T](typ)[asciiLower(s)]
+}
+
+// ambiguityMsg lists vals matched by s, mu must be held.
+func ambiguityMsg[T enumType](typ typeID, s string, vals []T) string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("%q is ambiguous, it matches ", s))
+	for i, v := range vals {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(formatValue(typ, v))
+	}
+	return sb.String()
+}
+
+// toString is the inverse of fromString, it ignores String method of T if any.
+func toString[T enumType](v T) string {
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.Kind() == reflect.String:
+		return rv.String()
+	case rv.CanInt():
+		return strconv.FormatInt(rv.Int(), 10)
+	default: // enumType permits only strings and integers
+		return strconv.FormatUint(rv.Uint(), 10)
+	}
+}
+
+// ParseLoose is like Parse but ignores case, "-" and "_" when matching s against values and their names,
+// e.g. "in-progress", "inProgress" and "IN_PROGRESS" are all parsed as "in_progress".
+// If s loosely matches several values, an error is returned instead of picking one of them,
+// unless one of them matches exactly.
+func ParseLoose[T enumType](s string) (T, error) {
+	if v, err := Parse[T](s); err == nil {
+		return v, nil
+	}
+	typ := idOf[T]()
+	key := looseKey(s)
+	mu.RLock()
+	defer mu.RUnlock()
+	vals, _ := groups[typ].([]T)
+	var matches []T
+	for _, v := range vals {
+		name, named := names[typeValue[T]{typ: typ, val: v}]
+		if named && looseKey(name) == key || typ.Kind() == reflect.String && looseKey(toString(v)) == key {
+			matches = append(matches, v)
+		}
+	}
+	var zero T
+	switch len(matches) {
+	case 0:
+		invalid, _ := fromString[T](s)
+		return zero, validationErr(typ, invalid, fmt.Sprintf("%q", s))
+	case 1:
+		return matches[0], nil
+	default:
+		return zero, errors.New(ambiguityMsg(typ, s, matches))
+	}
+}
+
+// looseKey normalizes s for ParseLoose.
+func looseKey(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, s)
+}
@@ -0,0 +1,220 @@
+package enum_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleParse() {
+	status, err := enum.Parse[Status]("merged")
+	fmt.Println(status, err)
+
+	_, err = enum.Parse[Status]("postponed")
+	fmt.Println(err)
+
+	access, err := enum.Parse[Access]("4")
+	fmt.Println(access, err)
+
+	for _, s := range []string{"3", "write", "99999999999999999999"} {
+		_, err = enum.Parse[Access](s)
+		fmt.Println(err)
+	}
+
+	type Nothing int
+	_, err = enum.Parse[Nothing]("1")
+	fmt.Println(err)
+
+	// Output:
+	// merged <nil>
+	// "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// 4 <nil>
+	// "3" is not a valid choice, allowed values are: 1, 2, 4
+	// "write" is not a valid choice, allowed values are: 1, 2, 4
+	// "99999999999999999999" is not a valid choice, allowed values are: 1, 2, 4
+	// Nothing doesn't have any definition
+}
+
+func ExampleParse_unsigned() {
+	type Port uint8
+	enum.Def[Port](80)
+	enum.Def[Port](255)
+
+	port, err := enum.Parse[Port]("255")
+	fmt.Println(port, err)
+
+	_, err = enum.Parse[Port]("-80")
+	fmt.Println(err)
+
+	// Output:
+	// 255 <nil>
+	// "-80" is not a valid choice, allowed values are: 80, 255
+}
+
+func ExampleParse_named() {
+	type Permission int
+	enum.DefNamed[Permission](1, "read")
+	enum.DefNamed[Permission](4, "write")
+
+	for _, s := range []string{"write", "4", "execute"} {
+		p, err := enum.Parse[Permission](s)
+		fmt.Println(p, err)
+	}
+
+	// Output:
+	// 4 <nil>
+	// 4 <nil>
+	// 0 "execute" is not a valid choice, allowed values are: "read" (1), "write" (4)
+}
+
+func TestParseConcurrentDef(t *testing.T) {
+	type Code int
+	var wg sync.WaitGroup
+	for i := range 100 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			enum.DefNamed(Code(i), fmt.Sprint("code", i))
+		}()
+		go func() {
+			defer wg.Done()
+			_, _ = enum.Parse[Code](fmt.Sprint("code", i))
+		}()
+	}
+	wg.Wait()
+	for i := range 100 {
+		if v, err := enum.Parse[Code](fmt.Sprint("code", i)); err != nil || v != Code(i) {
+			t.Errorf("Parse(code%d) = %v, %v", i, v, err)
+		}
+	}
+}
+
+func ExampleMustParse() {
+	defaultStatus := enum.MustParse[Status]("draft")
+	defaultAccess := enum.MustParse[Access]("1")
+	fmt.Println(defaultStatus, defaultAccess)
+
+	defer func() {
+		fmt.Println(recover())
+	}()
+	enum.MustParse[Status]("postponed")
+
+	// Output:
+	// draft 1
+	// enum: MustParse[Status]: "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+}
+
+func TestMustParse(t *testing.T) {
+	mustPanic(t, func() { enum.MustParse[Access]("3") })
+	mustPanic(t, func() { enum.MustParse[Access]("read") })
+	mustPanic(t, func() { enum.MustParse[Status]("DRAFT") })
+	if v := enum.MustParse[Access]("4"); v != AccessWrite {
+		t.Errorf("MustParse(4) = %v", v)
+	}
+}
+
+func ExampleParseOrDefault() {
+	for _, s := range []string{"merged", "", "postponed"} {
+		fmt.Println(enum.ParseOrDefault(s, StatusDraft))
+	}
+	fmt.Println(enum.ParseOrDefault[Access]("write", 0))
+	// Output:
+	// merged
+	// draft
+	// draft
+	// 0
+}
+
+func ExampleParseFold() {
+	for _, s := range []string{"Draft", "MERGED", "postponed"} {
+		status, err := enum.ParseFold[Status](s)
+		fmt.Printf("%q %v\n", status, err)
+	}
+
+	type Unit string
+	enum.Def[Unit]("m")
+	enum.Def[Unit]("M")
+	for _, s := range []string{"M", "m", "s"} {
+		unit, err := enum.ParseFold[Unit](s)
+		fmt.Printf("%q %v\n", unit, err)
+	}
+	enum.Def[Unit]("kg")
+	enum.Def[Unit]("KG")
+	_, err := enum.ParseFold[Unit]("Kg")
+	fmt.Println(err)
+
+	// Output:
+	// "draft" <nil>
+	// "merged" <nil>
+	// "" "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// "M" <nil>
+	// "m" <nil>
+	// "" "s" is not a valid choice, allowed values are: "m", "M"
+	// "Kg" is ambiguous, it matches "kg", "KG"
+}
+
+func TestNormalizeFoldAliases(t *testing.T) {
+	type Fold string
+	enum.DefAll[Fold]("open", "k")
+	if err := enum.DefAlias[Fold]("open", "opened"); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []Fold{"opened", "Opened", "OPEN"} {
+		if got, ok := enum.NormalizeFold(v); !ok || got != "open" {
+			t.Errorf("NormalizeFold(%q) = %q, %v, want open", v, got, ok)
+		}
+	}
+	if got, ok := enum.NormalizeFold[Fold]("K"); !ok || got != "k" {
+		t.Errorf("NormalizeFold(K) = %q, %v, want k", got, ok)
+	}
+	if enum.IsValidFold[Fold]("\u212a") { // Kelvin sign, which is folded to "k" in Unicode but not in ASCII
+		t.Error("IsValidFold(Kelvin sign) = true, want false")
+	}
+}
+
+func ExampleNormalizeFold() {
+	fmt.Println(enum.NormalizeFold(Status("Draft")))
+	fmt.Println(enum.NormalizeFold(Status("Postponed")))
+	fmt.Println(enum.IsValidFold(Status("CLOSED")), enum.IsValid(Status("CLOSED")))
+
+	type Size string
+	enum.DefAll[Size]("xl", "XL", "s")
+	fmt.Println(enum.NormalizeFold(Size("Xl")))
+	fmt.Println(enum.NormalizeFold(Size("XL")))
+	fmt.Println(enum.NormalizeFold(Size("S")))
+
+	// Output:
+	// draft true
+	// Postponed false
+	// true false
+	// Xl false
+	// XL true
+	// s true
+}
+
+func ExampleParseLoose() {
+	type TaskStatus string
+	enum.Def[TaskStatus]("todo")
+	enum.Def[TaskStatus]("in_progress")
+
+	for _, s := range []string{"in-progress", "inProgress", "IN_PROGRESS", "done"} {
+		status, err := enum.ParseLoose[TaskStatus](s)
+		fmt.Printf("%q %v\n", status, err)
+	}
+
+	type Permission int
+	enum.DefNamed[Permission](1, "read_only")
+	enum.DefNamed[Permission](2, "readOnly")
+	fmt.Println(enum.ParseLoose[Permission]("ReadOnly"))
+	fmt.Println(enum.ParseLoose[Permission]("readOnly"))
+
+	// Output:
+	// "in_progress" <nil>
+	// "in_progress" <nil>
+	// "in_progress" <nil>
+	// "" "done" is not a valid choice, allowed values are: "todo", "in_progress"
+	// 0 "ReadOnly" is ambiguous, it matches "read_only" (1), "readOnly" (2)
+	// 2 <nil>
+}
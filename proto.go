@@ -0,0 +1,34 @@
+package enum
+
+import (
+	"cmp"
+	"slices"
+)
+
+// FromProto defines values of protobuf enum as values of enum T, named after the protobuf ones,
+// as if each of them was defined with DefNamed. values is the map generated by protoc-gen-go, e.g. pb.Status_value.
+// Values are defined in ascending order and returned in that order. The zero value, conventionally
+// STATUS_UNSPECIFIED, is skipped unless includeZero is true. Calling it again with the same map is a no-op.
+// If several names share a value (allow_alias option), the smallest one is used as the name, the others as alias names.
+// Usage:
+//   type Status int32
+//   var statuses = enum.FromProto[Status](pb.Status_value, false)
+func FromProto[T ~int32](values map[string]int32, includeZero bool) []T {
+	byValue := map[int32][]string{}
+	for name, n := range values {
+		if n != 0 || includeZero {
+			byValue[n] = append(byValue[n], name)
+		}
+	}
+	vs := make([]T, 0, len(byValue))
+	for n := range byValue {
+		vs = append(vs, T(n))
+	}
+	slices.Sort(vs)
+	for _, v := range vs {
+		vNames := byValue[int32(v)]
+		slices.SortFunc(vNames, cmp.Compare)
+		DefNamed(v, vNames[0], vNames[1:]...)
+	}
+	return vs
+}
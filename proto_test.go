@@ -0,0 +1,44 @@
+package enum_test
+
+import (
+	"fmt"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleFromProto() {
+	// as generated by protoc-gen-go for:
+	//   enum Priority {
+	//     option allow_alias = true;
+	//     PRIORITY_UNSPECIFIED = 0;
+	//     PRIORITY_LOW = 1;
+	//     PRIORITY_HIGH = 2;
+	//     PRIORITY_URGENT = 2;
+	//   }
+	var Priority_value = map[string]int32{
+		"PRIORITY_UNSPECIFIED": 0,
+		"PRIORITY_LOW":         1,
+		"PRIORITY_HIGH":        2,
+		"PRIORITY_URGENT":      2,
+	}
+
+	type Priority int32
+	fmt.Println(enum.FromProto[Priority](Priority_value, false))
+	fmt.Println(enum.FromProto[Priority](Priority_value, false))
+	fmt.Println(enum.ValuesOf[Priority]())
+	fmt.Println(enum.Parse[Priority]("PRIORITY_URGENT"))
+	fmt.Println(enum.Validate[Priority](0))
+
+	type Severity int32
+	fmt.Println(enum.FromProto[Severity](Priority_value, true))
+	fmt.Println(enum.NameOf[Severity](0))
+
+	// Output:
+	// [1 2]
+	// [1 2]
+	// [1 2]
+	// 2 <nil>
+	// 0 is not a valid choice, allowed values are: "PRIORITY_LOW" (1), "PRIORITY_HIGH" (2)
+	// [0 1 2]
+	// PRIORITY_UNSPECIFIED true
+}
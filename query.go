@@ -0,0 +1,48 @@
+package enum
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// FromQuery parses the first value of query parameter key as enum T, see Parse.
+// Returns an error wrapping ErrMissingKey if the parameter is absent, so that a default can be applied,
+// otherwise the error of Parse prefixed with the parameter name.
+// Usage:
+//   status, err := enum.FromQuery[Status](r.URL.Query(), "status")
+//   if errors.Is(err, enum.ErrMissingKey) {
+//     status = StatusOpen
+//   } else if err != nil {
+//     http.Error(w, err.Error(), http.StatusBadRequest)
+//     return
+//   }
+func FromQuery[T enumType](values url.Values, key string) (T, error) {
+	vs, ok := values[key]
+	if !ok || len(vs) == 0 {
+		var zero T
+		return zero, fmt.Errorf("query parameter %q: %w", key, ErrMissingKey)
+	}
+	v, err := Parse[T](vs[0])
+	if err != nil {
+		return v, fmt.Errorf("query parameter %q: %w", key, err)
+	}
+	return v, nil
+}
+
+// AllFromQuery is like FromQuery but parses all values of query parameter key, e.g. ?status=open&status=draft.
+// Returns nil slice and the error of the first invalid value, if any.
+func AllFromQuery[T enumType](values url.Values, key string) ([]T, error) {
+	vs, ok := values[key]
+	if !ok || len(vs) == 0 {
+		return nil, fmt.Errorf("query parameter %q: %w", key, ErrMissingKey)
+	}
+	parsed := make([]T, 0, len(vs))
+	for _, s := range vs {
+		v, err := Parse[T](s)
+		if err != nil {
+			return nil, fmt.Errorf("query parameter %q: %w", key, err)
+		}
+		parsed = append(parsed, v)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,32 @@
+package enum_test
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleFromQuery() {
+	query, _ := url.ParseQuery("status=merged&access=4&access=1&other=postponed")
+
+	fmt.Println(enum.FromQuery[Status](query, "status"))
+	fmt.Println(enum.FromQuery[Status](query, "other"))
+
+	status, err := enum.FromQuery[Status](query, "missing")
+	if errors.Is(err, enum.ErrMissingKey) {
+		status = StatusOpen
+	}
+	fmt.Println(status, err)
+
+	fmt.Println(enum.AllFromQuery[Access](query, "access"))
+	fmt.Println(enum.AllFromQuery[Status](query, "access"))
+
+	// Output:
+	// merged <nil>
+	//  query parameter "other": "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// open query parameter "missing": enum: missing key
+	// [4 1] <nil>
+	// [] query parameter "access": "4" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+}
@@ -0,0 +1,17 @@
+package enum
+
+import "math/rand/v2"
+
+// Random returns a uniformly chosen defined value of enum T using r, or the default source if r is nil.
+// Reports false if enum T has no definitions.
+func Random[T enumType](r *rand.Rand) (T, bool) {
+	vals := snapshot[T]()
+	if len(vals) == 0 {
+		var zero T
+		return zero, false
+	}
+	if r == nil {
+		return vals[rand.IntN(len(vals))], true
+	}
+	return vals[r.IntN(len(vals))], true
+}
@@ -0,0 +1,34 @@
+package enum_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleRandom() {
+	r := rand.New(rand.NewPCG(7, 42))
+	for range 4 {
+		fmt.Println(enum.Random[Status](r))
+	}
+
+	type Nothing int
+	fmt.Println(enum.Random[Nothing](nil))
+
+	// Output:
+	// closed true
+	// closed true
+	// merged true
+	// open true
+	// 0 false
+}
+
+func TestRandomDefaultSource(t *testing.T) {
+	for range 100 {
+		if v, ok := enum.Random[Access](nil); !ok || !enum.IsValid(v) {
+			t.Fatalf("Random() = %v, %v", v, ok)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package enum
+
+import (
+	"reflect"
+	"strings"
+)
+
+// JSONSchema returns a JSON Schema fragment constraining values to the defined values of enum T,
+// e.g. for filling OpenAPI specs. Values are listed in the same order as ValuesOf, for stable diffs.
+// The type is "string" or "integer" depending on the underlying type of T, values are of its underlying type too,
+// so that MarshalJSON methods of T are not called. If some values are labeled, the description lists their labels.
+// Usage:
+//   schema := enum.JSONSchema[Access]()
+//   // {"type": "integer", "enum": [1, 2, 4], "description": "1: Read, 2: Write, 4: Execute"}
+func JSONSchema[T enumType]() map[string]any {
+	typ := idOf[T]()
+	mu.RLock()
+	defer mu.RUnlock()
+	vals, _ := groups[typ].([]T)
+	vals = withoutZero(typ, vals)
+	schema := map[string]any{"type": "integer"}
+	if typ.Kind() == reflect.String {
+		schema["type"] = "string"
+	}
+	values := make([]any, 0, len(vals))
+	var described []string
+	for _, v := range vals {
+		rv := reflect.ValueOf(v)
+		switch {
+		case rv.Kind() == reflect.String:
+			values = append(values, rv.String())
+		case rv.CanInt():
+			values = append(values, rv.Int())
+		default: // enumType permits only strings and integers
+			values = append(values, rv.Uint())
+		}
+		if label, ok := labels[typeValue[T]{typ: typ, val: v}]; ok {
+			described = append(described, toString(v)+": "+label)
+		}
+	}
+	schema["enum"] = values
+	if len(described) > 0 {
+		schema["description"] = strings.Join(described, ", ")
+	}
+	return schema
+}
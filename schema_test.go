@@ -0,0 +1,27 @@
+package enum_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleJSONSchema() {
+	type Perm uint8
+	enum.DefLabeled[Perm](1, "Read")
+	enum.DefLabeled[Perm](2, "Write")
+	enum.Def[Perm](4)
+
+	for _, schema := range []map[string]any{
+		enum.JSONSchema[Status](),
+		enum.JSONSchema[Perm](),
+	} {
+		data, _ := json.Marshal(schema)
+		fmt.Println(string(data))
+	}
+
+	// Output:
+	// {"enum":["draft","open","merged","closed"],"type":"string"}
+	// {"description":"1: Read, 2: Write","enum":[1,2,4],"type":"integer"}
+}
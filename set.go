@@ -0,0 +1,101 @@
+package enum
+
+// EnumSet is a set of values of enum T.
+// The zero value is an empty set ready to use.
+// EnumSet is not safe for concurrent use.
+type EnumSet[T enumType] struct {
+	m map[T]struct{}
+}
+
+// NewEnumSet returns a set containing vs.
+func NewEnumSet[T enumType](vs ...T) *EnumSet[T] {
+	s := &EnumSet[T]{}
+	s.Add(vs...)
+	return s
+}
+
+// EnumSetOfAll returns a set containing all defined values of enum T.
+func EnumSetOfAll[T enumType]() *EnumSet[T] {
+	return NewEnumSet(ValuesOf[T]()...)
+}
+
+// Add adds vs to the set, without checking whether they are defined.
+func (s *EnumSet[T]) Add(vs ...T) {
+	if s.m == nil {
+		s.m = make(map[T]struct{}, len(vs))
+	}
+	for _, v := range vs {
+		s.m[v] = struct{}{}
+	}
+}
+
+// AddValid adds vs to the set if all of them are defined.
+// Otherwise, returns the error of the first undefined value and leaves the set untouched.
+func (s *EnumSet[T]) AddValid(vs ...T) error {
+	for _, v := range vs {
+		if err := Validate(v); err != nil {
+			return err
+		}
+	}
+	s.Add(vs...)
+	return nil
+}
+
+// Remove removes vs from the set.
+func (s *EnumSet[T]) Remove(vs ...T) {
+	for _, v := range vs {
+		delete(s.m, v)
+	}
+}
+
+// Contains reports whether v is in the set.
+func (s *EnumSet[T]) Contains(v T) bool {
+	_, ok := s.m[v]
+	return ok
+}
+
+// Len returns the number of values in the set.
+func (s *EnumSet[T]) Len() int {
+	return len(s.m)
+}
+
+// Values returns values of the set in the same order as ValuesOf.
+// Other values, i.e. undefined values and aliases, if any were added, come last in ascending order.
+// It is safe to modify the returned slice.
+func (s *EnumSet[T]) Values() []T {
+	return orderedKeys(s.m)
+}
+
+// Union returns a new set with values that are in s or in other.
+func (s *EnumSet[T]) Union(other *EnumSet[T]) *EnumSet[T] {
+	u := &EnumSet[T]{}
+	for v := range s.m {
+		u.Add(v)
+	}
+	for v := range other.m {
+		u.Add(v)
+	}
+	return u
+}
+
+// Intersect returns a new set with values that are in both s and other.
+func (s *EnumSet[T]) Intersect(other *EnumSet[T]) *EnumSet[T] {
+	u := &EnumSet[T]{}
+	for v := range s.m {
+		if other.Contains(v) {
+			u.Add(v)
+		}
+	}
+	return u
+}
+
+// Difference returns a new set with values that are in s but not in other.
+func (s *EnumSet[T]) Difference(other *EnumSet[T]) *EnumSet[T] {
+	u := &EnumSet[T]{}
+	for v := range s.m {
+		if !other.Contains(v) {
+			u.Add(v)
+		}
+	}
+	return u
+}
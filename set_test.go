@@ -0,0 +1,48 @@
+package enum_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleEnumSet() {
+	terminal := enum.NewEnumSet(StatusClosed, StatusMerged)
+	all := enum.EnumSetOfAll[Status]()
+
+	fmt.Println(terminal.Values(), terminal.Len())
+	fmt.Println(terminal.Contains(StatusMerged), terminal.Contains(StatusDraft))
+	fmt.Println(all.Difference(terminal).Values())
+	fmt.Println(all.Intersect(terminal).Values())
+
+	var active enum.EnumSet[Status]
+	fmt.Println(active.AddValid(StatusOpen, "postponed"), active.Len())
+	active.Add(StatusDraft, StatusOpen, "postponed")
+	fmt.Println(active.Union(terminal).Values())
+
+	active.Remove("postponed")
+	fmt.Println(active.Values())
+
+	// Output:
+	// [merged closed] 2
+	// true false
+	// [draft open]
+	// [merged closed]
+	// "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed" 0
+	// [draft open merged closed postponed]
+	// [draft open]
+}
+
+func TestEnumSetAliasValues(t *testing.T) {
+	type Color string
+	enum.DefAll[Color]("gray", "black")
+	if err := enum.DefAlias[Color]("gray", "grey"); err != nil {
+		t.Fatal(err)
+	}
+	s := enum.NewEnumSet[Color]("grey", "white", "black")
+	if got, want := s.Values(), []Color{"black", "grey", "white"}; !slices.Equal(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+}
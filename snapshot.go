@@ -0,0 +1,125 @@
+package enum
+
+import (
+	"maps"
+	"reflect"
+)
+
+// registry holds copies of all package state, see Snapshot.
+// New package state must be added here, as well as to Clear.
+type registry struct {
+	groups            map[typeID]any
+	defs              map[any]int
+	names             map[any]string
+	named             map[typeName]any
+	aliases           map[any]any
+	validators        map[typeID]func(v any) error
+	labels            map[any]string
+	labeled           map[typeName]any
+	labeledFold       map[typeName]any
+	noSuggest         map[typeID]struct{}
+	formatters        map[typeID]any
+	frozen            map[typeID]struct{}
+	jsonLabels        map[typeID]LabelMode
+	errorLimits       map[typeID]int
+	errorFuncs        map[typeID]any
+	allowedFormatters map[typeID]func(vals any) []string
+	hinters           map[typeID]func(e *ValidationError) (any, string)
+	messagePrinter    func(lang, invalid string, allowed []string) string
+	metas             map[any]map[string]any
+	deprecations      map[any]any
+	zeroDisallowed    map[typeID]struct{}
+	registered        map[typeID]struct{}
+	sortedErrors      map[typeID]struct{}
+}
+
+// Snapshot captures definitions and settings of all enums, and returns a function that restores them.
+// It lets tests define throwaway enums without affecting each other, restore undoes Clear as well.
+// Restoring is not safe while other goroutines define values, e.g. in parallel tests.
+// Usage:
+//   func TestSomething(t *testing.T) {
+//     t.Cleanup(enum.Snapshot())
+//     enum.Def[Status]("archived")
+//   }
+func Snapshot() (restore func()) {
+	mu.RLock()
+	saved := copyRegistry()
+	mu.RUnlock()
+	return func() {
+		mu.Lock()
+		defer mu.Unlock()
+		groups = clipGroups(saved.groups)
+		defs = maps.Clone(saved.defs)
+		names = maps.Clone(saved.names)
+		named = maps.Clone(saved.named)
+		aliases = maps.Clone(saved.aliases)
+		validators = maps.Clone(saved.validators)
+		labels = maps.Clone(saved.labels)
+		labeled = maps.Clone(saved.labeled)
+		labeledFold = maps.Clone(saved.labeledFold)
+		noSuggest = maps.Clone(saved.noSuggest)
+		formatters = maps.Clone(saved.formatters)
+		frozen = maps.Clone(saved.frozen)
+		jsonLabels = maps.Clone(saved.jsonLabels)
+		errorLimits = maps.Clone(saved.errorLimits)
+		errorFuncs = maps.Clone(saved.errorFuncs)
+		allowedFormatters = maps.Clone(saved.allowedFormatters)
+		hinters = maps.Clone(saved.hinters)
+		messagePrinter = saved.messagePrinter
+		metas = cloneMetas(saved.metas)
+		deprecations = maps.Clone(saved.deprecations)
+		zeroDisallowed = maps.Clone(saved.zeroDisallowed)
+		registered = maps.Clone(saved.registered)
+		sortedErrors = maps.Clone(saved.sortedErrors)
+		clearCaches()
+	}
+}
+
+// copyRegistry copies package state, mu must be held.
+func copyRegistry() registry {
+	return registry{
+		groups:            clipGroups(groups),
+		defs:              maps.Clone(defs),
+		names:             maps.Clone(names),
+		named:             maps.Clone(named),
+		aliases:           maps.Clone(aliases),
+		validators:        maps.Clone(validators),
+		labels:            maps.Clone(labels),
+		labeled:           maps.Clone(labeled),
+		labeledFold:       maps.Clone(labeledFold),
+		noSuggest:         maps.Clone(noSuggest),
+		formatters:        maps.Clone(formatters),
+		frozen:            maps.Clone(frozen),
+		jsonLabels:        maps.Clone(jsonLabels),
+		errorLimits:       maps.Clone(errorLimits),
+		errorFuncs:        maps.Clone(errorFuncs),
+		allowedFormatters: maps.Clone(allowedFormatters),
+		hinters:           maps.Clone(hinters),
+		messagePrinter:    messagePrinter,
+		metas:             cloneMetas(metas),
+		deprecations:      maps.Clone(deprecations),
+		zeroDisallowed:    maps.Clone(zeroDisallowed),
+		registered:        maps.Clone(registered),
+		sortedErrors:      maps.Clone(sortedErrors),
+	}
+}
+
+// clipGroups copies groups, limiting capacity of each slice to its length.
+// Thus, appending to a restored slice doesn't overwrite elements of slices appended after the snapshot.
+func clipGroups(groups map[typeID]any) map[typeID]any {
+	clipped := make(map[typeID]any, len(groups))
+	for typ, vals := range groups {
+		rv := reflect.ValueOf(vals)
+		clipped[typ] = rv.Slice3(0, rv.Len(), rv.Len()).Interface()
+	}
+	return clipped
+}
+
+// cloneMetas copies metas along with maps they hold, since DefWith modifies them in place.
+func cloneMetas(metas map[any]map[string]any) map[any]map[string]any {
+	cloned := make(map[any]map[string]any, len(metas))
+	for k, meta := range metas {
+		cloned[k] = maps.Clone(meta)
+	}
+	return cloned
+}
@@ -0,0 +1,51 @@
+package enum_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleSnapshot() {
+	restore := enum.Snapshot()
+	enum.Def[Status]("archived")
+	enum.Clear[Access]()
+	fmt.Println(enum.ValuesOf[Status](), enum.ValuesOf[Access]())
+
+	restore()
+	fmt.Println(enum.ValuesOf[Status](), enum.ValuesOf[Access]())
+	// Output:
+	// [draft open merged closed archived] []
+	// [draft open merged closed] [1 2 4]
+}
+
+func TestSnapshot(t *testing.T) {
+	type Zone string
+	enum.DefNamed[Zone]("eu", "europe")
+	t.Cleanup(enum.Snapshot())
+
+	t.Run("define", func(t *testing.T) {
+		t.Cleanup(enum.Snapshot())
+		enum.Def[Zone]("us")
+		enum.DefLabeled[Zone]("asia", "Asia")
+		enum.Freeze[Zone]()
+	})
+
+	if got := enum.ValuesOf[Zone](); len(got) != 1 || got[0] != "eu" {
+		t.Errorf("ValuesOf = %v after restore, want [eu]", got)
+	}
+	if enum.IsFrozen[Zone]() {
+		t.Error("Zone is frozen after restore")
+	}
+	if _, ok := enum.FromLabel[Zone]("Asia"); ok {
+		t.Error("label is defined after restore")
+	}
+	if v, err := enum.Parse[Zone]("europe"); err != nil || v != "eu" {
+		t.Errorf("Parse(europe) = %v, %v after restore", v, err)
+	}
+	enum.Def[Zone]("af")
+	if got := enum.ValuesOf[Zone](); len(got) != 2 || got[1] != "af" {
+		t.Errorf("ValuesOf = %v, want [eu af]", got)
+	}
+}
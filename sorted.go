@@ -0,0 +1,77 @@
+package enum
+
+import "slices"
+
+// ValuesSorted returns defined values of enum T in ascending natural order,
+// i.e. integers numerically and strings lexicographically. Unlike ValuesOf, the order doesn't depend on definitions.
+// It is safe to modify the returned slice.
+func ValuesSorted[T enumType]() []T {
+	mu.RLock()
+	defer mu.RUnlock()
+	return slices.Clone(sorted[T](idOf[T]()))
+}
+
+// ValuesSortedFunc returns defined values of enum T sorted by compare, see slices.SortFunc.
+// Values that compare equal keep the order of ValuesOf. Nil compare falls back to ValuesSorted.
+// It is safe to modify the returned slice.
+// Usage:
+//   byWeight := enum.ValuesSortedFunc(func(a, b Priority) int {
+//     return cmp.Compare(weights[a], weights[b])
+//   })
+func ValuesSortedFunc[T enumType](compare func(a, b T) int) []T {
+	if compare == nil {
+		return ValuesSorted[T]()
+	}
+	vals := ValuesOf[T]()
+	slices.SortStableFunc(vals, compare)
+	return vals
+}
+
+// ValuesBetween returns defined values of enum T from lo to hi inclusive, in ascending order.
+// Values are compared by their natural order, i.e. string enums are compared lexicographically.
+// Values are looked up by binary search over a sorted copy of definitions, which is cached until they change.
+// It is safe to modify the returned slice.
+// Usage:
+//   clientErrors := enum.ValuesBetween[HTTPCode](400, 499)
+func ValuesBetween[T enumType](lo, hi T) []T {
+	mu.RLock()
+	defer mu.RUnlock()
+	vals := sorted[T](idOf[T]())
+	from, _ := slices.BinarySearch(vals, lo)
+	to, found := slices.BinarySearch(vals, hi)
+	if found {
+		to++
+	}
+	if from >= to {
+		return nil
+	}
+	return slices.Clone(vals[from:to])
+}
+
+// Min returns the smallest defined value of enum T by natural order, see ValuesBetween.
+// Reports false if enum T doesn't have any definition.
+func Min[T enumType]() (T, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	vals := sorted[T](idOf[T]())
+	if len(vals) == 0 {
+		var zero T
+		return zero, false
+	}
+	return vals[0], true
+}
+
+// Max returns the largest defined value of enum T by natural order, see ValuesBetween.
+// Reports false if enum T doesn't have any definition.
+// Usage:
+//   level = min(max(level, lo), hi) // where lo, _ := enum.Min[Level]() and hi, _ := enum.Max[Level]()
+func Max[T enumType]() (T, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	vals := sorted[T](idOf[T]())
+	if len(vals) == 0 {
+		var zero T
+		return zero, false
+	}
+	return vals[len(vals)-1], true
+}
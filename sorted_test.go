@@ -0,0 +1,65 @@
+package enum_test
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleValuesSorted() {
+	fmt.Println(enum.ValuesSorted[Status]())
+	fmt.Println(enum.ValuesOf[Status]())
+	// Output:
+	// [closed draft merged open]
+	// [draft open merged closed]
+}
+
+func ExampleValuesSortedFunc() {
+	weights := map[Status]int{StatusMerged: 1, StatusClosed: 1, StatusOpen: 2, StatusDraft: 3}
+	fmt.Println(enum.ValuesSortedFunc(func(a, b Status) int {
+		return cmp.Compare(weights[a], weights[b])
+	}))
+	fmt.Println(enum.ValuesSortedFunc[Status](nil))
+	// Output:
+	// [merged closed open draft]
+	// [closed draft merged open]
+}
+
+func ExampleValuesBetween() {
+	type HTTPCode int
+	enum.DefAll[HTTPCode](200, 404, 201, 500, 400, 301, 418)
+
+	fmt.Println(enum.ValuesBetween[HTTPCode](400, 499))
+	fmt.Println(enum.ValuesBetween[HTTPCode](200, 201))
+	fmt.Println(enum.ValuesBetween[HTTPCode](202, 299))
+	enum.Def[HTTPCode](204)
+	fmt.Println(enum.ValuesBetween[HTTPCode](202, 299))
+	fmt.Println(enum.ValuesBetween[HTTPCode](500, 400))
+	fmt.Println(enum.ValuesBetween[Status]("d", "n"))
+	// Output:
+	// [400 404 418]
+	// [200 201]
+	// []
+	// [204]
+	// []
+	// [draft merged]
+}
+
+func ExampleMin() {
+	type Priority int8
+	enum.DefAll[Priority](3, -2, 10, 0)
+	type Nothing int
+
+	fmt.Println(enum.Min[Priority]())
+	fmt.Println(enum.Max[Priority]())
+	fmt.Println(enum.Min[Status]())
+	fmt.Println(enum.Max[Status]())
+	fmt.Println(enum.Max[Nothing]())
+	// Output:
+	// -2 true
+	// 10 true
+	// closed true
+	// open true
+	// 0 false
+}
@@ -0,0 +1,110 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// ErrNull is returned when scanning NULL into a wrapper that doesn't permit it.
+var ErrNull = errors.New("enum: NULL value")
+
+// SQL wraps a value of enum T to validate it when scanning database rows.
+// String enums are scanned from text columns, integer enums from integer columns.
+// Usage:
+//   var status enum.SQL[Status]
+//   err := db.QueryRow("SELECT status FROM pulls WHERE id = $1", id).Scan(&status)
+type SQL[T enumType] struct {
+	Val T
+}
+
+// Value implements driver.Valuer, it returns the underlying value.
+func (s SQL[T]) Value() (driver.Value, error) {
+	return driverValue(s.Val)
+}
+
+// Scan implements sql.Scanner, it validates the scanned value.
+// NULL leaves the zero value and returns ErrNull, which can be checked with errors.Is.
+func (s *SQL[T]) Scan(src any) error {
+	var zero T
+	s.Val = zero
+	return scan(src, &s.Val)
+}
+
+// Value implements driver.Valuer, it returns the underlying value.
+func (v Value[T]) Value() (driver.Value, error) {
+	return driverValue(v.Val)
+}
+
+// Scan implements sql.Scanner, it validates the scanned value, see SQL.Scan.
+// On failure, the wrapped value is left untouched.
+func (v *Value[T]) Scan(src any) error {
+	return scan(src, &v.Val)
+}
+
+// driverValue converts v to one of the types permitted by driver.Value.
+func driverValue[T enumType](v T) (driver.Value, error) {
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.Kind() == reflect.String:
+		return rv.String(), nil
+	case rv.CanInt():
+		return rv.Int(), nil
+	default: // enumType permits only strings and integers
+		u := rv.Uint()
+		if u > math.MaxInt64 {
+			return nil, fmt.Errorf("enum: %d overflows int64", u)
+		}
+		return int64(u), nil
+	}
+}
+
+// scan converts src to T, validates it and stores it in dst.
+// On failure, dst is left untouched.
+func scan[T enumType](src any, dst *T) error {
+	if src == nil {
+		return fmt.Errorf("%w for %s", ErrNull, idOf[T]())
+	}
+	v, ok := fromDriver[T](src)
+	if !ok {
+		return fmt.Errorf("enum: can't scan %T into %s", src, idOf[T]())
+	}
+	if err := Validate(v); err != nil {
+		return err
+	}
+	*dst = v
+	return nil
+}
+
+// fromDriver converts src to T without validation.
+// Integer enums accept decimal text as well, since some drivers return integers as text.
+// Reports false if src can't be represented as T.
+func fromDriver[T enumType](src any) (v T, ok bool) {
+	rv := reflect.ValueOf(&v).Elem()
+	switch src := src.(type) {
+	case string:
+		return fromString[T](src)
+	case []byte:
+		return fromString[T](string(src))
+	case int64:
+		switch {
+		case rv.CanInt():
+			if rv.OverflowInt(src) {
+				return v, false
+			}
+			rv.SetInt(src)
+		case rv.CanUint():
+			if src < 0 || rv.OverflowUint(uint64(src)) {
+				return v, false
+			}
+			rv.SetUint(uint64(src))
+		default:
+			return v, false
+		}
+	default:
+		return v, false
+	}
+	return v, true
+}
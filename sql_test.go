@@ -0,0 +1,49 @@
+package enum_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleSQL() {
+	var status enum.SQL[Status]
+	fmt.Println(status.Scan([]byte("merged")), status.Val)
+	fmt.Println(status.Scan("postponed"))
+	fmt.Println(status.Scan(int64(1)))
+
+	var access enum.SQL[Access]
+	fmt.Println(access.Scan(int64(4)), access.Val)
+	fmt.Println(access.Scan(int64(99)))
+
+	if err := access.Scan(nil); errors.Is(err, enum.ErrNull) {
+		fmt.Println("NULL", access.Val)
+	}
+
+	v, err := enum.SQL[Access]{Val: AccessWrite}.Value()
+	fmt.Printf("%T %v %v\n", v, v, err)
+
+	// Output:
+	// <nil> merged
+	// "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// enum: can't scan int64 into enum_test.Status
+	// <nil> 4
+	// 99 is not a valid choice, allowed values are: 1, 2, 4
+	// NULL 0
+	// int64 4 <nil>
+}
+
+func ExampleValue_sql() {
+	access := enum.Value[Access]{Val: AccessRead}
+	fmt.Println(access.Scan([]byte("4")), access.Val) // e.g. MySQL returns integers as text
+	fmt.Println(access.Scan(int64(3)), access.Val)
+	fmt.Println(access.Scan(nil), access.Val)
+	fmt.Println(access.Value())
+
+	// Output:
+	// <nil> 4
+	// 3 is not a valid choice, allowed values are: 1, 2, 4 4
+	// enum: NULL value for enum_test.Access 4
+	// 4 <nil>
+}
@@ -0,0 +1,282 @@
+package enum
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// ValidateStruct validates enum fields of struct s, which may be a pointer to a struct.
+// Fields of enum types with definitions are validated, as well as fields tagged `enum:"validate"`,
+// the latter must reach an enum type with definitions, e.g. *Status, []Status or any holding a Status.
+// Exported fields of nested and embedded structs, pointers, interfaces, slices, arrays and maps (both keys and values)
+// are walked recursively, including fields promoted from unexported embedded structs.
+// Wrappers such as Value and JSON are validated as the values they wrap, Null fields only if they are not NULL.
+// Returns errors prefixed with field paths, e.g. "Reviews[1].Status: ..." or `Permissions["bob"]: ...`,
+// joined by errors.Join. Map entries are reported in order of their keys.
+// Usage:
+//   type PullRequest struct {
+//     Status  Status
+//     Reviews []struct{ Verdict Verdict }
+//   }
+//   if err := enum.ValidateStruct(pr); err != nil {
+//     http.Error(w, err.Error(), http.StatusBadRequest)
+//   }
+func ValidateStruct(s any) error {
+	rv := reflect.ValueOf(s)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("enum: ValidateStruct expects a struct, got %T", s)
+	}
+	w := structWalker{visited: map[visit]struct{}{}}
+	w.walk(reflect.ValueOf(s), "")
+	return errors.Join(w.errs...)
+}
+
+// fieldValidator is implemented by wrappers, so that they are validated as the values they wrap, rather than walked as structs.
+// Null has its own notion of validity.
+type fieldValidator interface {
+	validateField() error
+}
+
+// validateField validates Val unless it is NULL.
+func (n Null[T]) validateField() error {
+	if !n.Valid {
+		return nil
+	}
+	return Validate(n.Val)
+}
+
+// validateField validates Val.
+func (v Value[T]) validateField() error {
+	return Validate(v.Val)
+}
+
+// validateField validates Val.
+func (j JSON[T]) validateField() error {
+	return Validate(j.Val)
+}
+
+// validateField validates Val.
+func (s SQL[T]) validateField() error {
+	return Validate(s.Val)
+}
+
+// validateField validates Val.
+func (t Text[T]) validateField() error {
+	return Validate(t.Val)
+}
+
+// validateField validates Val.
+func (b Binary[T]) validateField() error {
+	return Validate(b.Val)
+}
+
+// maxWalkDepth limits nesting of interfaces, slices and arrays walked by ValidateStruct,
+// as a guard against cycles through them, which are not tracked like pointers and maps.
+const maxWalkDepth = 100
+
+// structWalker accumulates errors of ValidateStruct.
+type structWalker struct {
+	errs    []error
+	visited map[visit]struct{} // pointers and maps already walked, to not loop over cyclic structures
+	depth   int                // levels of interfaces, slices and arrays being walked
+	enums   int                // number of enum values validated so far
+}
+
+// visit identifies a walked pointer or map. Type is part of the key,
+// since a pointer to the first field of a struct has the same address as a pointer to the struct.
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+func (w *structWalker) walkStruct(rv reflect.Value, path string) {
+	for i := range rv.NumField() {
+		f := rv.Type().Field(i)
+		if !f.IsExported() && !(f.Anonymous && f.Type.Kind() == reflect.Struct) {
+			continue // exported fields of unexported embedded structs are promoted, so they are walked
+		}
+		fPath := f.Name
+		if path != "" {
+			fPath = path + "." + f.Name
+		}
+		if f.Tag.Get("enum") == "validate" {
+			w.walkTagged(rv.Field(i), fPath)
+			continue
+		}
+		w.walk(rv.Field(i), fPath)
+	}
+}
+
+// walkTagged walks field rv tagged `enum:"validate"`, which must reach an enum with definitions,
+// either by its type, e.g. *Status or []Status, or by the values it holds, e.g. an interface holding a Status.
+func (w *structWalker) walkTagged(rv reflect.Value, path string) {
+	if reachesEnum(rv.Type()) {
+		w.walk(rv, path)
+		return
+	}
+	enums := w.enums
+	w.walk(rv, path)
+	if w.enums == enums {
+		w.add(path, ValidateValue(rv))
+	}
+}
+
+// reachesEnum reports whether values of type t are enums with definitions or wrappers of them,
+// possibly through pointers, slices, arrays and maps.
+func reachesEnum(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array:
+		return reachesEnum(t.Elem())
+	case reflect.Map:
+		return reachesEnum(t.Key()) || reachesEnum(t.Elem())
+	}
+	if t.Implements(reflect.TypeFor[fieldValidator]()) {
+		return true
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	_, isEnum := validators[t]
+	return isEnum
+}
+
+func (w *structWalker) walk(rv reflect.Value, path string) {
+	if !rv.CanInterface() {
+		// unexported embedded struct, its exported fields can be interfaced
+		w.walkStruct(rv, path)
+		return
+	}
+	if fv, ok := rv.Interface().(fieldValidator); ok {
+		w.enums++
+		w.add(path, fv.validateField())
+		return
+	}
+	mu.RLock()
+	_, isEnum := validators[rv.Type()]
+	mu.RUnlock()
+	if isEnum {
+		w.enums++
+		w.add(path, ValidateValue(rv))
+		return
+	}
+	switch rv.Kind() {
+	case reflect.Struct:
+		w.walkStruct(rv, path)
+	case reflect.Pointer, reflect.Map:
+		if rv.IsNil() || w.seen(rv) {
+			return
+		}
+		if rv.Kind() == reflect.Pointer {
+			w.walk(rv.Elem(), path)
+			return
+		}
+		w.walkMap(rv, path)
+	case reflect.Interface:
+		if !rv.IsNil() && w.enter(path) {
+			w.walk(rv.Elem(), path)
+			w.depth--
+		}
+	case reflect.Slice, reflect.Array:
+		if rv.Len() > 0 && mayHoldEnums(rv.Type().Elem()) && w.enter(path) {
+			for i := range rv.Len() {
+				w.walk(rv.Index(i), fmt.Sprintf("%s[%d]", path, i))
+			}
+			w.depth--
+		}
+	}
+}
+
+// mayHoldEnums reports whether values of type t may be or contain enums, it is false for types of basic kinds
+// which are not enums, e.g. bytes of a payload, so that their elements are not walked one by one.
+func mayHoldEnums(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Struct, reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Array:
+		return true
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	_, isEnum := validators[t]
+	return isEnum
+}
+
+// enter counts a level of nesting through an interface, slice or array, whose cycles are not tracked like pointers.
+// Reports false, adding an error, if there are too many levels already.
+func (w *structWalker) enter(path string) bool {
+	if w.depth >= maxWalkDepth {
+		w.add(path, fmt.Errorf("enum: ValidateStruct: nested deeper than %d levels", maxWalkDepth))
+		return false
+	}
+	w.depth++
+	return true
+}
+
+// walkMap walks keys and values of map rv, in order of keys, so that errors are stable.
+// Keys of other than integer, float and string kinds are ordered by their formatted paths.
+func (w *structWalker) walkMap(rv reflect.Value, path string) {
+	type entry struct {
+		key  reflect.Value
+		path string
+	}
+	entries := make([]entry, 0, rv.Len())
+	for _, k := range rv.MapKeys() {
+		format := "%s[%v]"
+		if k.Kind() == reflect.String {
+			format = "%s[%q]"
+		}
+		entries = append(entries, entry{key: k, path: fmt.Sprintf(format, path, k)})
+	}
+	slices.SortFunc(entries, func(a, b entry) int {
+		if c, ok := compareKeys(a.key, b.key); ok {
+			return c
+		}
+		return strings.Compare(a.path, b.path)
+	})
+	for _, e := range entries {
+		w.walk(e.key, e.path)
+		w.walk(rv.MapIndex(e.key), e.path)
+	}
+}
+
+// compareKeys compares map keys a and b by value, reports false if they are not of the same ordered kind,
+// e.g. keys of a map[any]T.
+func compareKeys(a, b reflect.Value) (int, bool) {
+	if a.Kind() == reflect.Interface {
+		a, b = a.Elem(), b.Elem()
+	}
+	if a.Kind() != b.Kind() {
+		return 0, false
+	}
+	switch {
+	case a.CanInt():
+		return cmp.Compare(a.Int(), b.Int()), true
+	case a.CanUint():
+		return cmp.Compare(a.Uint(), b.Uint()), true
+	case a.CanFloat():
+		return cmp.Compare(a.Float(), b.Float()), true
+	case a.Kind() == reflect.String:
+		return strings.Compare(a.String(), b.String()), true
+	}
+	return 0, false
+}
+
+// seen reports whether pointer or map rv was already walked, and marks it as walked.
+func (w *structWalker) seen(rv reflect.Value) bool {
+	v := visit{ptr: rv.Pointer(), typ: rv.Type()}
+	if _, ok := w.visited[v]; ok {
+		return true
+	}
+	w.visited[v] = struct{}{}
+	return false
+}
+
+func (w *structWalker) add(path string, err error) {
+	if err != nil {
+		w.errs = append(w.errs, fmt.Errorf("%s: %w", path, err))
+	}
+}
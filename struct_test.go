@@ -0,0 +1,235 @@
+package enum_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleValidateStruct() {
+	type Review struct {
+		Access Access
+		Note   string
+	}
+	type PullRequest struct {
+		Title    string
+		Status   Status
+		Previous enum.Null[Status]
+		Reviews  []Review
+		Reviewer *Review
+		Labels   [2]Status
+		Extra    any `enum:"validate"`
+	}
+
+	err := enum.ValidateStruct(&PullRequest{
+		Status:   "postponed",
+		Reviews:  []Review{{Access: AccessRead}, {Access: 3}},
+		Reviewer: &Review{Access: 8},
+		Labels:   [2]Status{StatusOpen, "wip"},
+		Extra:    "wip",
+	})
+	fmt.Println(err)
+
+	fmt.Println(enum.ValidateStruct(PullRequest{
+		Status:   StatusOpen,
+		Previous: enum.Null[Status]{Val: StatusDraft, Valid: true},
+		Labels:   [2]Status{StatusOpen, StatusMerged},
+		Extra:    AccessWrite,
+	}))
+
+	// Output:
+	// Status: "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// Reviews[1].Access: 3 is not a valid choice, allowed values are: 1, 2, 4
+	// Reviewer.Access: 8 is not a valid choice, allowed values are: 1, 2, 4
+	// Labels[1]: "wip" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// Extra: enum: string is not an enum with definitions: enum: no definitions
+	// <nil>
+}
+
+func TestValidateStruct(t *testing.T) {
+	type Node struct {
+		Access Access
+		Next   *Node
+		hidden Access
+	}
+	n := &Node{Access: AccessRead, hidden: 3}
+	n.Next = n
+	if err := enum.ValidateStruct(n); err != nil {
+		t.Errorf("ValidateStruct(cycle) = %v", err)
+	}
+	n.Access = 5
+	if err := enum.ValidateStruct(n); !errors.Is(err, enum.ErrInvalidValue) || len(err.(interface{ Unwrap() []error }).Unwrap()) != 1 {
+		t.Errorf("ValidateStruct(cycle) = %v, want single ErrInvalidValue", err)
+	}
+	if err := enum.ValidateStruct(StatusOpen); err == nil {
+		t.Error("ValidateStruct(non-struct) = nil, want error")
+	}
+
+	type Inner struct {
+		S, T Status
+	}
+	type Outer struct {
+		P *Status
+		I *Inner
+	}
+	in := &Inner{S: StatusOpen, T: "bogus"}
+	if err := enum.ValidateStruct(Outer{P: &in.S, I: in}); err == nil || !strings.HasPrefix(err.Error(), "I.T: ") {
+		t.Errorf("ValidateStruct(pointer to first field) = %v, want error about I.T", err)
+	}
+}
+
+func TestValidateStructEmbedded(t *testing.T) {
+	type base struct {
+		Status Status
+	}
+	type Audit struct {
+		Access Access
+	}
+	type Request struct {
+		base
+		*Audit
+		hidden Status
+	}
+	req := Request{base: base{Status: "postponed"}, Audit: &Audit{Access: 3}, hidden: "ignored"}
+	err := enum.ValidateStruct(&req)
+	want := `base.Status: "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"` + "\n" +
+		`Audit.Access: 3 is not a valid choice, allowed values are: 1, 2, 4`
+	if err == nil || err.Error() != want {
+		t.Errorf("got %v, want %s", err, want)
+	}
+	_ = req.hidden
+}
+
+func TestValidateStructDeep(t *testing.T) {
+	type Item struct {
+		Status *Status
+	}
+	type Order struct {
+		Items       []Item
+		Permissions map[string]Access
+		Counts      map[Status]int
+		Payload     any
+		Levels      map[int]Access
+	}
+	postponed := Status("postponed")
+	order := Order{
+		Items:       []Item{{Status: &StatusOpen}, {}, {Status: &postponed}},
+		Permissions: map[string]Access{"bob": 3, "alice": AccessRead, "carol": 8},
+		Counts:      map[Status]int{StatusOpen: 1, "wip": 2},
+		Payload:     map[string]any{"access": Access(5)},
+		Levels:      map[int]Access{10: 3, 2: 3, -1: AccessRead},
+	}
+	var paths []string
+	for _, err := range enum.ValidateStruct(order).(interface{ Unwrap() []error }).Unwrap() {
+		paths = append(paths, strings.SplitN(err.Error(), ": ", 2)[0])
+	}
+	want := []string{`Items[2].Status`, `Permissions["bob"]`, `Permissions["carol"]`, `Counts["wip"]`, `Payload["access"]`, `Levels[2]`, `Levels[10]`}
+	if !slices.Equal(paths, want) {
+		t.Errorf("got errors at %q, want %q", paths, want)
+	}
+
+	cyclic := map[string]any{}
+	cyclic["self"] = cyclic
+	var deep any = Access(3)
+	for range 200 {
+		deep = []any{deep}
+	}
+	if err := enum.ValidateStruct(struct{ Cyclic, Deep any }{cyclic, deep}); err == nil || !strings.HasPrefix(err.Error(), "Deep[0]") {
+		t.Errorf("got %v, want error about Deep only", err)
+	}
+}
+
+func TestValidateStructTagged(t *testing.T) {
+	type Filter struct {
+		Status   *Status   `enum:"validate"`
+		Statuses []Status  `enum:"validate"`
+		Extra    any       `enum:"validate"`
+		Nested   []any     `enum:"validate"`
+		Note     *string   `enum:"validate"`
+		Notes    []any     `enum:"validate"`
+		Missing  *struct{} `enum:"validate"`
+	}
+	err := enum.ValidateStruct(Filter{
+		Statuses: []Status{StatusOpen, "wip"},
+		Extra:    &StatusDraft,
+		Nested:   []any{AccessRead},
+		Notes:    []any{"note"},
+	})
+	var paths []string
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+		paths = append(paths, strings.SplitN(err.Error(), ": ", 2)[0])
+	}
+	want := []string{`Statuses[1]`, `Note`, `Notes`, `Missing`}
+	if !slices.Equal(paths, want) {
+		t.Errorf("got errors at %q, want %q", paths, want)
+	}
+}
+
+func TestValidateStructWrappers(t *testing.T) {
+	type Wrapped struct {
+		V enum.Value[Status]
+		J enum.JSON[Status]
+		S enum.SQL[Access]
+		T enum.Text[Status]
+		B enum.Binary[Access]
+		N enum.Null[Status]
+	}
+	err := enum.ValidateStruct(Wrapped{
+		V: enum.Value[Status]{Val: "wip"},
+		J: enum.JSON[Status]{Val: "wip"},
+		S: enum.SQL[Access]{Val: 3},
+		T: enum.Text[Status]{Val: "wip"},
+		B: enum.Binary[Access]{Val: 3},
+	})
+	var paths []string
+	for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+		paths = append(paths, strings.SplitN(err.Error(), ": ", 2)[0])
+	}
+	if want := []string{"V", "J", "S", "T", "B"}; !slices.Equal(paths, want) {
+		t.Errorf("got errors at %q, want %q", paths, want)
+	}
+	valid := Wrapped{
+		V: enum.Value[Status]{Val: StatusOpen},
+		J: enum.JSON[Status]{Val: StatusOpen},
+		S: enum.SQL[Access]{Val: AccessRead},
+		T: enum.Text[Status]{Val: StatusOpen},
+		B: enum.Binary[Access]{Val: AccessRead},
+	}
+	if err := enum.ValidateStruct(valid); err != nil {
+		t.Errorf("ValidateStruct(valid) = %v", err)
+	}
+}
+
+func TestValidateStructLongChain(t *testing.T) {
+	type Node struct {
+		Status Status
+		Next   *Node
+		Items  []any
+	}
+	head := &Node{Status: StatusOpen}
+	for range 1000 {
+		head = &Node{Status: StatusDraft, Next: head, Items: []any{StatusMerged}}
+	}
+	if err := enum.ValidateStruct(head); err != nil {
+		t.Errorf("ValidateStruct(long chain) = %v", err)
+	}
+}
+
+func BenchmarkValidateStructPayload(b *testing.B) {
+	type Upload struct {
+		Status  Status
+		Payload []byte
+		Sizes   [][]int
+	}
+	u := Upload{Status: StatusOpen, Payload: make([]byte, 5<<20), Sizes: [][]int{make([]int, 1<<20)}}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := enum.ValidateStruct(u); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package enum
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+)
+
+const (
+	// maxSuggestDistance is the max edit distance between input and the suggested value.
+	maxSuggestDistance = 2
+	// maxSuggestCandidates caps the work done for suggestions, larger enums get none.
+	maxSuggestCandidates = 1000
+	// maxHintCandidates is like maxSuggestCandidates but for errors of Validate, which is called more often than Parse.
+	maxHintCandidates = 100
+)
+
+// keys are types that opted out of suggestions via DisableSuggestions.
+var noSuggest = map[typeID]struct{}{}
+
+// DisableSuggestions turns off "did you mean" hints in errors of enum T.
+func DisableSuggestions[T enumType]() {
+	mu.Lock()
+	defer mu.Unlock()
+	noSuggest[idOf[T]()] = struct{}{}
+}
+
+// suggest returns the value of enum typ whose value or name s is most likely a misspelling of,
+// along with that value or name. Reports false if there is no close enough candidate, mu must be held.
+func suggest[T enumType](typ typeID, s string) (found T, match string, ok bool) {
+	if _, ok := noSuggest[typ]; ok {
+		return found, "", false
+	}
+	vals, _ := groups[typ].([]T)
+	vals = withoutZero(typ, vals)
+	if len(vals) > maxSuggestCandidates {
+		return found, "", false
+	}
+	bestDist := maxSuggestDistance + 1
+	consider := func(v T, c string) {
+		if d := editDistance(s, c, bestDist); d < bestDist && d <= len(s)/2 {
+			found, match, bestDist = v, c, d
+		}
+	}
+	for _, v := range vals {
+		if name, ok := names[typeValue[T]{typ: typ, val: v}]; ok {
+			consider(v, name)
+		}
+		if typ.Kind() == reflect.String {
+			consider(v, toString(v))
+		}
+	}
+	return found, match, match != ""
+}
+
+// parseHintOf returns hintOf for errors of Parse of input s, which suggests names as well as values.
+func parseHintOf[T enumType](s string) func(e *ValidationError) (any, string) {
+	return func(e *ValidationError) (any, string) {
+		mu.RLock()
+		defer mu.RUnlock()
+		v, match, ok := suggest[T](e.typ, s)
+		if !ok {
+			return nil, ""
+		}
+		return v, strconv.Quote(match)
+	}
+}
+
+// hint returns the value of vals, which are defined values of string enum typ, that v is a misspelling of,
+// if v is close enough to exactly one of them. Reports false for integer enums, mu must be held.
+func hint[T enumType](typ typeID, v T, vals []T) (T, bool) {
+	var found T
+	if _, ok := noSuggest[typ]; ok || typ.Kind() != reflect.String || len(vals) > maxHintCandidates {
+		return found, false
+	}
+	var candidates [][]rune
+	if current, _ := groups[typ].([]T); len(current) == len(vals) && len(vals) > 0 && &current[0] == &vals[0] {
+		candidates = runes[T](typ) // vals are still the current definitions, since groups are never modified in place
+	} else {
+		for _, c := range vals {
+			candidates = append(candidates, []rune(toString(c)))
+		}
+	}
+	s := []rune(toString(v))
+	var rows []int
+	matches := 0
+	for i, c := range candidates {
+		var d int
+		d, rows = runeDistance(s, c, maxSuggestDistance+1, rows)
+		if d <= maxSuggestDistance && d <= len(s)/2 {
+			found = vals[i]
+			matches++
+		}
+	}
+	return found, matches == 1
+}
+
+// editDistance returns Levenshtein distance between a and b in runes.
+// Computation stops early returning limit once the distance is known to reach it.
+func editDistance(a, b string, limit int) int {
+	d, _ := runeDistance([]rune(a), []rune(b), limit, nil)
+	return d
+}
+
+// runeDistance is like editDistance but for runes, it uses rows as a scratch buffer and returns it for reuse.
+func runeDistance(ra, rb []rune, limit int, rows []int) (int, []int) {
+	if d := len(ra) - len(rb); d >= limit || -d >= limit {
+		return limit, rows
+	}
+	rows = slices.Grow(rows[:0], 2*(len(rb)+1))[:2*(len(rb)+1)]
+	prev, curr := rows[:len(rb)+1], rows[len(rb)+1:]
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin >= limit {
+			return limit, rows
+		}
+		prev, curr = curr, prev
+	}
+	return min(prev[len(rb)], limit), rows
+}
@@ -0,0 +1,102 @@
+package enum_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleDisableSuggestions() {
+	_, err := enum.Parse[Status]("mergd")
+	fmt.Println(err)
+
+	type Color string
+	enum.Def[Color]("red")
+	enum.Def[Color]("green")
+	enum.DisableSuggestions[Color]()
+	_, err = enum.Parse[Color]("gren")
+	fmt.Println(err)
+
+	// Output:
+	// "mergd" is not a valid choice, allowed values are: "draft", "open", "merged", "closed" (did you mean "merged"?)
+	// "gren" is not a valid choice, allowed values are: "red", "green"
+}
+
+func TestParseSuggestions(t *testing.T) {
+	type Permission int
+	enum.DefNamed[Permission](1, "read")
+	enum.DefNamed[Permission](4, "write")
+
+	for _, tc := range []struct {
+		input, want string
+	}{
+		{"wirte", `"wirte" is not a valid choice, allowed values are: "read" (1), "write" (4) (did you mean "write"?)`},
+		{"red", `"red" is not a valid choice, allowed values are: "read" (1), "write" (4) (did you mean "read"?)`},
+		{"x", `"x" is not a valid choice, allowed values are: "read" (1), "write" (4)`},
+		{"3", `"3" is not a valid choice, allowed values are: "read" (1), "write" (4)`},
+		{"execute", `"execute" is not a valid choice, allowed values are: "read" (1), "write" (4)`},
+	} {
+		if _, err := enum.Parse[Permission](tc.input); err == nil || err.Error() != tc.want {
+			t.Errorf("Parse(%q) = %v, want %s", tc.input, err, tc.want)
+		}
+	}
+	_, err := enum.Parse[Permission]("wirte")
+	var ve *enum.ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("Parse(wirte) = %v, want *ValidationError", err)
+	}
+	if h, ok := ve.Suggestion(); !ok || h != Permission(4) {
+		t.Errorf("Suggestion() = %v, %v, want 4, true", h, ok)
+	}
+}
+
+func ExampleValidationError_Suggestion() {
+	err := enum.Validate(Status("closd"))
+	fmt.Println(err)
+
+	var ve *enum.ValidationError
+	if errors.As(err, &ve) {
+		fmt.Println(ve.Suggestion())
+	}
+
+	// Output:
+	// "closd" is not a valid choice, allowed values are: "draft", "open", "merged", "closed" (did you mean "closed"?)
+	// closed true
+}
+
+func TestValidateHints(t *testing.T) {
+	type Size string
+	enum.DefAll[Size]("small", "smell", "large")
+	type Level int
+	enum.DefAll[Level](10, 20)
+
+	tests := []struct {
+		err  error
+		want any
+	}{
+		{enum.Validate[Size]("lage"), Size("large")},
+		{enum.Validate[Size]("smal"), nil}, // ambiguous
+		{enum.Validate[Size]("x"), nil},
+		{enum.Validate[Level](11), nil},
+	}
+	for _, tc := range tests {
+		var ve *enum.ValidationError
+		if !errors.As(tc.err, &ve) {
+			t.Fatalf("got %v, want ValidationError", tc.err)
+		}
+		if got, _ := ve.Suggestion(); got != tc.want {
+			t.Errorf("Suggestion() of %q = %v, want %v", ve.Error(), got, tc.want)
+		}
+	}
+
+	type Large string
+	for i := range 101 {
+		enum.Def(Large(fmt.Sprintf("value%03d", i)))
+	}
+	if err := enum.Validate[Large]("value1000"); strings.Contains(err.Error(), "did you mean") {
+		t.Errorf("got hint for a large enum: %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+package enum
+
+import "reflect"
+
+// Text wraps a value of enum T to validate it when decoding text,
+// it implements encoding.TextMarshaler and encoding.TextUnmarshaler.
+// Text is accepted in the same form as in Parse.
+type Text[T enumType] struct {
+	Val T
+}
+
+// MarshalText encodes the value, see MarshalText function.
+func (t Text[T]) MarshalText() ([]byte, error) {
+	return MarshalText(t.Val)
+}
+
+// UnmarshalText parses the value, see Parse.
+// On failure, the wrapped value is left untouched.
+func (t *Text[T]) UnmarshalText(text []byte) error {
+	return UnmarshalText(text, &t.Val)
+}
+
+// MarshalText encodes v as-is for string enums, integer enums are encoded as their names,
+// or in decimal form if they are not named.
+// It is meant for types that can't be wrapped in Text.
+func MarshalText[T enumType](v T) ([]byte, error) {
+	typ := idOf[T]()
+	if typ.Kind() == reflect.String {
+		return []byte(toString(v)), nil
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	return []byte(nameOrString(typ, v)), nil
+}
+
+// UnmarshalText parses text into dst, see Parse.
+// On failure, dst is left untouched.
+// It is meant for types that can't be wrapped in Text.
+func UnmarshalText[T enumType](text []byte, dst *T) error {
+	v, err := Parse[T](string(text))
+	if err != nil {
+		return err
+	}
+	*dst = v
+	return nil
+}
@@ -0,0 +1,57 @@
+package enum_test
+
+import (
+	"encoding"
+	"fmt"
+
+	"github.com/0xcafe-io/enum"
+)
+
+var (
+	_ encoding.TextMarshaler   = enum.Text[Status]{}
+	_ encoding.TextUnmarshaler = (*enum.Text[Status])(nil)
+)
+
+func ExampleText() {
+	var status enum.Text[Status]
+	fmt.Println(status.UnmarshalText([]byte("open")), status.Val)
+	fmt.Println(status.UnmarshalText([]byte("postponed")), status.Val)
+
+	var access enum.Text[Access]
+	fmt.Println(access.UnmarshalText([]byte("4")), access.Val)
+	fmt.Println(access.UnmarshalText([]byte("write")))
+
+	b, _ := enum.Text[Access]{Val: AccessComment}.MarshalText()
+	fmt.Println(string(b))
+
+	// Output:
+	// <nil> open
+	// "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed" open
+	// <nil> 4
+	// "write" is not a valid choice, allowed values are: 1, 2, 4
+	// 2
+}
+
+func ExampleMarshalText() {
+	type Permission int
+	enum.DefNamed[Permission](1, "read")
+	enum.Def[Permission](2)
+
+	for _, p := range []Permission{1, 2} {
+		b, err := enum.MarshalText(p)
+		fmt.Println(string(b), err)
+	}
+
+	var p Permission
+	for _, s := range []string{"read", "1", "2", "write"} {
+		fmt.Println(enum.UnmarshalText([]byte(s), &p), p)
+	}
+
+	// Output:
+	// read <nil>
+	// 2 <nil>
+	// <nil> 1
+	// <nil> 1
+	// <nil> 2
+	// "write" is not a valid choice, allowed values are: "read" (1), 2 2
+}
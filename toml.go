@@ -0,0 +1,56 @@
+package enum
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// UnmarshalTOML converts a value decoded by github.com/BurntSushi/toml to a value of enum T, validates it and stores it in dst.
+// TOML strings are parsed as Parse does, so integer enums accept names given in DefNamed,
+// TOML integers are accepted by integer enums only and must fit T.
+// On failure, dst is left untouched and the error mentions the original value.
+// Usage:
+//   func (s *Status) UnmarshalTOML(v any) error {
+//     return enum.UnmarshalTOML(v, s)
+//   }
+func UnmarshalTOML[T enumType](v any, dst *T) error {
+	typ := idOf[T]()
+	switch tv := v.(type) {
+	case string:
+		parsed, err := Parse[T](tv)
+		if err != nil {
+			return err
+		}
+		*dst = parsed
+		return nil
+	case int64:
+		var parsed T
+		rv := reflect.ValueOf(&parsed).Elem()
+		switch {
+		case rv.Kind() == reflect.String:
+			return fmt.Errorf("enum: can't unmarshal TOML integer %d into %s", tv, typ)
+		case rv.CanInt():
+			if rv.OverflowInt(tv) {
+				return fmt.Errorf("enum: TOML integer %d overflows %s", tv, typ)
+			}
+			rv.SetInt(tv)
+		default: // enumType permits only strings and integers
+			if tv < 0 || rv.OverflowUint(uint64(tv)) {
+				return fmt.Errorf("enum: TOML integer %d overflows %s", tv, typ)
+			}
+			rv.SetUint(uint64(tv))
+		}
+		if err := Validate(parsed); err != nil {
+			return err
+		}
+		*dst = parsed
+		return nil
+	default:
+		return fmt.Errorf("enum: can't unmarshal TOML %T %v into %s", v, v, typ)
+	}
+}
+
+// UnmarshalTOML implements toml.Unmarshaler of github.com/BurntSushi/toml, see UnmarshalTOML function.
+func (v *Value[T]) UnmarshalTOML(data any) error {
+	return UnmarshalTOML(data, &v.Val)
+}
@@ -0,0 +1,34 @@
+package enum_test
+
+import (
+	"fmt"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleUnmarshalTOML() {
+	type Level int8
+	enum.DefNamed[Level](1, "low")
+	enum.DefNamed[Level](2, "high")
+
+	// values as decoded by github.com/BurntSushi/toml
+	for _, v := range []any{int64(2), "low", int64(3), int64(258), "hihg", 2.0} {
+		var level Level
+		err := enum.UnmarshalTOML(v, &level)
+		fmt.Println(level, err)
+	}
+
+	var status enum.Value[Status]
+	fmt.Println(status.UnmarshalTOML("merged"), status.Val)
+	fmt.Println(status.UnmarshalTOML(int64(1)), status.Val)
+
+	// Output:
+	// 2 <nil>
+	// 1 <nil>
+	// 0 3 is not a valid choice, allowed values are: "low" (1), "high" (2)
+	// 0 enum: TOML integer 258 overflows enum_test.Level
+	// 0 "hihg" is not a valid choice, allowed values are: "low" (1), "high" (2) (did you mean "high"?)
+	// 0 enum: can't unmarshal TOML float64 2 into enum_test.Level
+	// <nil> merged
+	// enum: can't unmarshal TOML integer 1 into enum_test.Status merged
+}
@@ -0,0 +1,51 @@
+package enum
+
+// Value wraps a value of enum T to validate it when decoding.
+// Usage:
+//   type Request struct {
+//     Status enum.Value[Status] `json:"status"`
+//   }
+type Value[T enumType] struct {
+	Val T
+}
+
+// Wrap wraps v, it allows to call functions of the package as methods, without specifying T:
+//   if err := enum.Wrap(status).Validate(); err != nil {
+//     return err
+//   }
+func Wrap[T enumType](v T) Value[T] {
+	return Value[T]{Val: v}
+}
+
+// Unwrap returns the wrapped value.
+func (v Value[T]) Unwrap() T {
+	return v.Val
+}
+
+// IsValid reports whether the wrapped value is defined, see IsValid function.
+func (v Value[T]) IsValid() bool {
+	return IsValid(v.Val)
+}
+
+// Validate checks whether the wrapped value is defined, see Validate function.
+func (v Value[T]) Validate() error {
+	return Validate(v.Val)
+}
+
+// String returns the wrapped value for display, see String function.
+func (v Value[T]) String() string {
+	return String(v.Val)
+}
+
+// MarshalJSON encodes the bare value, as if it wasn't wrapped, or its label, see SetJSONLabels.
+func (v Value[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON(v.Val)
+}
+
+// UnmarshalJSON decodes the value and validates it, the error is the same as of Validate.
+// JSON null leaves the value untouched. Integer enums accept quoted numbers as well, e.g. "4",
+// names given in DefNamed and labels given in DefLabeled.
+// On failure, the wrapped value is left untouched.
+func (v *Value[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(data, &v.Val)
+}
@@ -0,0 +1,72 @@
+package enum_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleValue_json() {
+	type PullRequest struct {
+		Status enum.Value[Status] `json:"status"`
+		Access enum.Value[Access] `json:"access"`
+	}
+
+	for _, input := range []string{
+		`{"status": "merged", "access": 4}`,
+		`{"status": "open", "access": "2"}`,
+		`{"status": null, "access": 1}`,
+		`{"status": "postponed"}`,
+		`{"access": "3"}`,
+		`{"access": 99}`,
+	} {
+		pr := PullRequest{Status: enum.Value[Status]{Val: StatusDraft}}
+		err := json.Unmarshal([]byte(input), &pr)
+		b, _ := json.Marshal(pr)
+		fmt.Println(string(b), err)
+	}
+
+	// Output:
+	// {"status":"merged","access":4} <nil>
+	// {"status":"open","access":2} <nil>
+	// {"status":"draft","access":1} <nil>
+	// {"status":"draft","access":0} "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// {"status":"draft","access":0} "3" is not a valid choice, allowed values are: 1, 2, 4
+	// {"status":"draft","access":0} 99 is not a valid choice, allowed values are: 1, 2, 4
+}
+
+func ExampleValue_labels() {
+	type Role int
+	enum.DefLabeled[Role](1, "read")
+	enum.DefLabeled[Role](4, "write")
+	enum.DefNamed[Role](8, "admin")
+
+	for _, data := range []string{`4`, `"write"`, `"8"`, `"admin"`, `"delete"`, `3`} {
+		var role enum.Value[Role]
+		err := json.Unmarshal([]byte(data), &role)
+		fmt.Println(role.Val, err)
+	}
+	// Output:
+	// 4 <nil>
+	// 4 <nil>
+	// 8 <nil>
+	// 8 <nil>
+	// 0 "delete" is not a valid choice, allowed values are: "read" (1), "write" (4), "admin" (8)
+	// 0 3 is not a valid choice, allowed values are: "read" (1), "write" (4), "admin" (8)
+}
+
+func ExampleWrap() {
+	status := enum.Wrap(StatusOpen)
+	fmt.Println(status, status.IsValid(), status.Validate(), status.Unwrap() == StatusOpen)
+
+	access := enum.Wrap[Access](3)
+	fmt.Println(access.IsValid(), access.Validate())
+
+	data, _ := json.Marshal(status)
+	fmt.Println(string(data))
+	// Output:
+	// open true <nil> true
+	// false 3 is not a valid choice, allowed values are: 1, 2, 4
+	// "open"
+}
@@ -0,0 +1,43 @@
+package enum
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// MarshalXML encodes the value as element text in the form accepted by Parse.
+func (v Value[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(toString(v.Val), start)
+}
+
+// UnmarshalXML parses element text, surrounding whitespace is ignored, see Parse.
+// On failure, the wrapped value is left untouched.
+func (v *Value[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	parsed, err := Parse[T](strings.TrimSpace(s))
+	if err != nil {
+		return fmt.Errorf("enum: element <%s>: %w", start.Name.Local, err)
+	}
+	v.Val = parsed
+	return nil
+}
+
+// MarshalXMLAttr encodes the value as attribute in the form accepted by Parse.
+func (v Value[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: toString(v.Val)}, nil
+}
+
+// UnmarshalXMLAttr parses attribute value, surrounding whitespace is ignored, see Parse.
+// On failure, the wrapped value is left untouched.
+func (v *Value[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	parsed, err := Parse[T](strings.TrimSpace(attr.Value))
+	if err != nil {
+		return fmt.Errorf("enum: attribute %s: %w", attr.Name.Local, err)
+	}
+	v.Val = parsed
+	return nil
+}
@@ -0,0 +1,44 @@
+package enum_test
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleValue_xml() {
+	type PullRequest struct {
+		Status enum.Value[Status] `xml:"status"`
+		Access enum.Value[Access] `xml:"access,attr"`
+	}
+
+	for _, input := range []string{
+		`<PullRequest access="4"><status> merged
+		</status></PullRequest>`,
+		`<PullRequest access="3"><status>open</status></PullRequest>`,
+		`<PullRequest access="1"><status>postponed</status></PullRequest>`,
+	} {
+		var pr PullRequest
+		err := xml.Unmarshal([]byte(input), &pr)
+		fmt.Println(pr.Status.Val, pr.Access.Val, err)
+	}
+
+	b, _ := xml.Marshal(PullRequest{
+		Status: enum.Value[Status]{Val: StatusOpen},
+		Access: enum.Value[Access]{Val: AccessComment},
+	})
+	fmt.Println(string(b))
+
+	var pr PullRequest
+	err := xml.Unmarshal([]byte(`<PullRequest><status>x</status></PullRequest>`), &pr)
+	fmt.Println(errors.Is(err, enum.ErrInvalidValue))
+
+	// Output:
+	// merged 4 <nil>
+	//  0 enum: attribute access: "3" is not a valid choice, allowed values are: 1, 2, 4
+	//  1 enum: element <status>: "postponed" is not a valid choice, allowed values are: "draft", "open", "merged", "closed"
+	// <PullRequest access="2"><status>open</status></PullRequest>
+	// true
+}
@@ -0,0 +1,35 @@
+package enum
+
+import "fmt"
+
+// UnmarshalYAML decodes a YAML scalar into dst and validates it, see Parse.
+// It is meant for hand-written UnmarshalYAML methods, unmarshal is the function passed to them
+// by gopkg.in/yaml.v2 and gopkg.in/yaml.v3 (the latter still supports such methods).
+// Sequences and mappings are rejected. On failure, dst is left untouched.
+// Usage:
+//   func (s *Status) UnmarshalYAML(unmarshal func(any) error) error {
+//     return enum.UnmarshalYAML(unmarshal, s)
+//   }
+func UnmarshalYAML[T enumType](unmarshal func(any) error, dst *T) error {
+	// YAML decoders put any scalar into a string, but reject sequences and mappings.
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return fmt.Errorf("enum: can't unmarshal YAML into %s: %w", idOf[T](), err)
+	}
+	v, err := Parse[T](s)
+	if err != nil {
+		return err
+	}
+	*dst = v
+	return nil
+}
+
+// MarshalYAML encodes the bare value, as if it wasn't wrapped.
+func (v Value[T]) MarshalYAML() (any, error) {
+	return v.Val, nil
+}
+
+// UnmarshalYAML decodes the value and validates it, see UnmarshalYAML function.
+func (v *Value[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	return UnmarshalYAML(unmarshal, &v.Val)
+}
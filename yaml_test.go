@@ -0,0 +1,46 @@
+package enum_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xcafe-io/enum"
+)
+
+// yamlScalar imitates unmarshal function passed by YAML decoders for a scalar node.
+func yamlScalar(s string) func(any) error {
+	return func(v any) error {
+		p, ok := v.(*string)
+		if !ok {
+			return fmt.Errorf("yaml: cannot unmarshal !!str into %T", v)
+		}
+		*p = s
+		return nil
+	}
+}
+
+// yamlSequence imitates unmarshal function passed by YAML decoders for a sequence node.
+func yamlSequence(v any) error {
+	return errors.New("yaml: unmarshal errors:\n  line 1: cannot unmarshal !!seq into string")
+}
+
+func ExampleUnmarshalYAML() {
+	var status Status
+	fmt.Println(enum.UnmarshalYAML(yamlScalar("merged"), &status), status)
+	fmt.Println(enum.UnmarshalYAML(yamlScalar("mergd"), &status), status)
+	fmt.Println(enum.UnmarshalYAML(yamlSequence, &status), status)
+
+	var access enum.Value[Access]
+	fmt.Println(access.UnmarshalYAML(yamlScalar("4")), access.Val)
+	fmt.Println(access.UnmarshalYAML(yamlScalar("99")), access.Val)
+	fmt.Println(access.MarshalYAML())
+
+	// Output:
+	// <nil> merged
+	// "mergd" is not a valid choice, allowed values are: "draft", "open", "merged", "closed" (did you mean "merged"?) merged
+	// enum: can't unmarshal YAML into enum_test.Status: yaml: unmarshal errors:
+	//   line 1: cannot unmarshal !!seq into string merged
+	// <nil> 4
+	// "99" is not a valid choice, allowed values are: 1, 2, 4 4
+	// 4 <nil>
+}
@@ -0,0 +1,57 @@
+package enum
+
+import (
+	"errors"
+	"slices"
+)
+
+// keys are types given in DisallowZero.
+var zeroDisallowed = map[typeID]struct{}{}
+
+// errZero is the reason of errors about zero values of enums given in DisallowZero.
+var errZero = errors.Join(ErrEmptyValue, ErrInvalidValue)
+
+// DisallowZero makes the zero value of enum T invalid, even if it is defined, e.g. "" or 0 which are never legitimate.
+// Validate and Parse return an error wrapping both ErrEmptyValue and ErrInvalidValue for it, instead of listing allowed values.
+// The zero value is left out of ValuesOf, Count, AllowedValues, JSONSchema, Corpus and error messages as well.
+// It is undone by Clear. DisallowZero is meant to be called once, during initialization.
+// Usage:
+//   func init() {
+//     enum.DisallowZero[Status]()
+//   }
+func DisallowZero[T enumType]() {
+	typ := idOf[T]()
+	mu.Lock()
+	defer mu.Unlock()
+	changed(typ)
+	zeroDisallowed[typ] = struct{}{}
+}
+
+// IsZeroDisallowed reports whether the zero value of enum T is made invalid via DisallowZero.
+func IsZeroDisallowed[T enumType]() bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	_, ok := zeroDisallowed[idOf[T]()]
+	return ok
+}
+
+// isDisallowedZero reports whether v is the zero value of enum typ given in DisallowZero, mu must be held.
+func isDisallowedZero[T enumType](typ typeID, v T) bool {
+	var zero T
+	if v != zero {
+		return false
+	}
+	_, ok := zeroDisallowed[typ]
+	return ok
+}
+
+
+// withoutZero returns vals of enum typ without the zero value if it is disallowed via DisallowZero.
+// vals are returned as-is otherwise, so the result must not be modified, mu must be held.
+func withoutZero[T enumType](typ typeID, vals []T) []T {
+	var zero T
+	if _, ok := zeroDisallowed[typ]; !ok || !slices.Contains(vals, zero) {
+		return vals
+	}
+	return slices.DeleteFunc(slices.Clone(vals), func(v T) bool { return v == zero })
+}
@@ -0,0 +1,73 @@
+package enum_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/0xcafe-io/enum"
+)
+
+func ExampleDisallowZero() {
+	type Tier string
+	enum.DefAll[Tier]("", "free", "pro") // "" is defined by mistake
+	enum.DisallowZero[Tier]()
+
+	fmt.Println(enum.IsValid[Tier](""), enum.IsZeroDisallowed[Tier]())
+	err := enum.Validate[Tier]("")
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, enum.ErrEmptyValue), errors.Is(err, enum.ErrInvalidValue))
+	fmt.Println(enum.ValidateAll[Tier]("pro", ""))
+
+	enum.Clear[Tier]()
+	enum.Def[Tier]("")
+	fmt.Println(enum.IsValid[Tier](""), enum.IsZeroDisallowed[Tier]())
+
+	// Output:
+	// false true
+	// Tier value is empty
+	// true true
+	// index 1: Tier value is empty
+	// true false
+}
+
+func TestDisallowZero(t *testing.T) {
+	type Grade int
+	enum.DefAll[Grade](1, 2)
+	enum.DisallowZero[Grade]()
+	if _, err := enum.Parse[Grade]("0"); !errors.Is(err, enum.ErrInvalidValue) {
+		t.Errorf("Parse(0) = %v, want ErrInvalidValue", err)
+	}
+	if err := enum.Validate[Grade](3); errors.Is(err, enum.ErrEmptyValue) {
+		t.Errorf("Validate(3) = %v, want no ErrEmptyValue", err)
+	}
+	if v, _, ok := enum.AsValidationError[Grade](enum.Validate[Grade](0)); !ok || v != 0 {
+		t.Errorf("AsValidationError = %v, %v, want 0, true", v, ok)
+	}
+}
+
+func TestDisallowZeroListing(t *testing.T) {
+	t.Cleanup(enum.Snapshot())
+	type Z string
+	enum.DefAll[Z]("", "a", "b")
+	if err := enum.Validate[Z]("c"); err == nil || err.Error() != `"c" is not a valid choice, allowed values are: "", "a", "b"` {
+		t.Errorf("Validate(c) = %v before DisallowZero", err) // caches the list, which must be invalidated
+	}
+	enum.DisallowZero[Z]()
+	if err := enum.Validate[Z]("c"); err == nil || err.Error() != `"c" is not a valid choice, allowed values are: "a", "b"` {
+		t.Errorf("Validate(c) = %v, want zero value not listed", err)
+	}
+	if got := enum.AllowedValues[Z](); got != `"a", "b"` {
+		t.Errorf("AllowedValues = %s", got)
+	}
+	if got := enum.ValuesOf[Z](); !slices.Equal(got, []Z{"a", "b"}) || enum.Count[Z]() != 2 {
+		t.Errorf("ValuesOf = %q, Count = %d", got, enum.Count[Z]())
+	}
+	if valid, invalid := enum.Corpus[Z](); slices.Contains(valid, "") || !slices.Contains(invalid, "") {
+		t.Errorf("Corpus = %q, %q, want zero value among invalid samples only", valid, invalid)
+	}
+	if _, err := enum.Parse[Z](""); !errors.Is(err, enum.ErrEmptyValue) {
+		t.Errorf("Parse(empty) = %v, want ErrEmptyValue", err)
+	}
+}